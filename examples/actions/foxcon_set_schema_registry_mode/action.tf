@@ -0,0 +1,12 @@
+# Switch a new registry to IMPORT mode before migrating schemas with their original ids
+action "foxcon_set_schema_registry_mode" "import" {
+  config {
+    mode = "IMPORT"
+  }
+}
+
+action "foxcon_set_schema_registry_mode" "rw" {
+  config {
+    mode = "READWRITE"
+  }
+}
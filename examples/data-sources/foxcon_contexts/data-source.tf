@@ -0,0 +1,7 @@
+data "foxcon_contexts" "all" {
+  rest_endpoint = "http://localhost:8081"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
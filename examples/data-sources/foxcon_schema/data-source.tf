@@ -0,0 +1,22 @@
+data "foxcon_schema" "orders" {
+  rest_endpoint = "http://localhost:8081"
+  subject_name  = "orders-value"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
+
+# Same schema looked up by its id
+data "foxcon_schema" "orders_by_id" {
+  rest_endpoint = "http://localhost:8081"
+  id            = data.foxcon_schema.orders.id
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
+
+output "orders_schema_fingerprint" {
+  value = data.foxcon_schema.orders.fingerprint
+}
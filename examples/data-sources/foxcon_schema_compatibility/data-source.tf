@@ -0,0 +1,18 @@
+data "foxcon_schema_compatibility" "orders" {
+  rest_endpoint = "http://localhost:8081"
+  subject_name  = "orders-value"
+  schema_type   = "AVRO"
+  schema_file   = "${path.module}/schemas/orders.avsc"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+
+  # Fail the plan before an incompatible schema reaches producers
+  lifecycle {
+    postcondition {
+      condition     = self.is_compatible
+      error_message = join("\n", self.messages)
+    }
+  }
+}
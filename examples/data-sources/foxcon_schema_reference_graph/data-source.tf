@@ -0,0 +1,16 @@
+data "foxcon_schema_reference_graph" "customer" {
+  rest_endpoint = "http://localhost:8081"
+  subject_name  = "customer-value"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
+
+# Subjects that would break when customer-value changes
+output "customer_dependents" {
+  value = distinct([
+    for node in data.foxcon_schema_reference_graph.customer.nodes : node.subject
+    if node.direction == "referenced_by"
+  ])
+}
@@ -0,0 +1,8 @@
+data "foxcon_subject_mode" "orders" {
+  rest_endpoint = "http://localhost:8081"
+  subject_name  = "orders-value"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
@@ -0,0 +1,21 @@
+data "foxcon_subjects" "orders" {
+  rest_endpoint  = "http://localhost:8081"
+  subject_prefix = "orders"
+  subject_regex  = "-value$"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
+
+resource "foxcon_subject_cleanup" "orders" {
+  for_each = data.foxcon_subjects.orders.subjects
+
+  rest_endpoint  = "http://localhost:8081"
+  subject_name   = each.value
+  cleanup_method = "KEEP_ACTIVE_ONLY"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
@@ -22,3 +22,47 @@ provider "foxcon" {
   schema_registry_api_key       = "test"                                             # optionally use SCHEMA_REGISTRY_API_KEY env var
   schema_registry_api_secret    = "test"                                             # optionally use SCHEMA_REGISTRY_API_SECRET env var
 }
+
+# Self-managed Schema Registry cluster behind a private CA with mutual TLS
+provider "foxcon" {
+  schema_registry_rest_endpoint      = "https://schema-registry.example.com:8081" # optionally use SCHEMA_REGISTRY_REST_ENDPOINT env var
+  schema_registry_api_key            = "test"                                     # optionally use SCHEMA_REGISTRY_API_KEY env var
+  schema_registry_api_secret         = "test"                                     # optionally use SCHEMA_REGISTRY_API_SECRET env var
+  schema_registry_ca_certificate     = "/etc/ssl/private-ca.pem"                  # optionally use SCHEMA_REGISTRY_CA_CERTIFICATE env var
+  schema_registry_client_certificate = "/etc/ssl/client.pem"                      # optionally use SCHEMA_REGISTRY_CLIENT_CERTIFICATE env var
+  schema_registry_client_key         = "/etc/ssl/client-key.pem"                  # optionally use SCHEMA_REGISTRY_CLIENT_KEY env var
+}
+
+# Confluent Cloud Schema Registry authenticated with OAuth client credentials
+provider "foxcon" {
+  schema_registry_rest_endpoint = "https://psrc-abcde.uksouth.azure.confluent.cloud" # optionally use SCHEMA_REGISTRY_REST_ENDPOINT env var
+
+  schema_registry_oauth {
+    token_url          = "https://login.example.com/oauth2/token"
+    client_id          = "test"
+    client_secret      = "test"
+    scope              = "schema_registry"
+    identity_pool_id   = "pool-abcde"
+    logical_cluster_id = "lsrc-abcde"
+  }
+}
+
+# Several Schema Registry clusters selected on a resource level with the registry attribute
+provider "foxcon" {
+  schema_registries = {
+    stage = {
+      rest_endpoint = "https://psrc-stage.uksouth.azure.confluent.cloud"
+      credentials = {
+        key    = "test"
+        secret = "test"
+      }
+    }
+    prod = {
+      rest_endpoint = "https://psrc-prod.uksouth.azure.confluent.cloud"
+      credentials = {
+        key    = "test"
+        secret = "test"
+      }
+    }
+  }
+}
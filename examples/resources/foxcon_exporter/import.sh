@@ -0,0 +1 @@
+terraform import foxcon_exporter.dc2 dc2
\ No newline at end of file
@@ -0,0 +1,20 @@
+resource "foxcon_exporter" "dc2" {
+  rest_endpoint         = "http://localhost:8081"
+  name                  = "dc2"
+  subject_prefix        = "orders"
+  context_type          = "CUSTOM"
+  context_name          = ".dc1"
+  subject_rename_format = "dc1.$${subject}"
+
+  destination {
+    rest_endpoint  = "http://dc2:8081"
+    key            = "dc2-key"
+    secret         = "dc2-secret"
+    secret_version = 1
+  }
+
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
@@ -0,0 +1 @@
+terraform import foxcon_rule_set.orders default/orders-value
\ No newline at end of file
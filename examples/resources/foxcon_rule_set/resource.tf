@@ -0,0 +1,51 @@
+resource "foxcon_rule_set" "orders" {
+  rest_endpoint = "http://localhost:8081"
+  subject_name  = "orders-value"
+
+  domain_rule {
+    name       = "check-amount"
+    kind       = "CONDITION"
+    mode       = "WRITE"
+    type       = "CEL"
+    expr       = "message.amount >= 0"
+    on_failure = "DLQ"
+    params = {
+      "dlq.topic" = "orders-dlq"
+    }
+  }
+
+  migration_rule {
+    name = "rename-amount"
+    kind = "TRANSFORM"
+    mode = "UPGRADE"
+    type = "JSONATA"
+    expr = "$merge([$sift($, function($v, $k) {$k != 'amount'}), {'total': $.'amount'}])"
+  }
+
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
+
+# Registry level override rule set
+resource "foxcon_rule_set" "registry" {
+  rest_endpoint = "http://localhost:8081"
+  override      = true
+
+  domain_rule {
+    name = "encrypt-pii"
+    kind = "TRANSFORM"
+    mode = "WRITEREAD"
+    type = "ENCRYPT"
+    params = {
+      "encrypt.kek.name" = "pii-kek"
+    }
+    on_failure = "ERROR,NONE"
+  }
+
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
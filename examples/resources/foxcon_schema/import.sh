@@ -0,0 +1 @@
+terraform import foxcon_schema.customer customer-value
\ No newline at end of file
@@ -0,0 +1,39 @@
+resource "foxcon_schema" "customer" {
+  rest_endpoint = "http://localhost:8081"
+  subject_name  = "customer-value"
+  schema_type   = "AVRO"
+  schema = jsonencode({
+    type      = "record"
+    name      = "Customer"
+    namespace = "com.example"
+    fields = [
+      { name = "id", type = "string" },
+      { name = "address", type = "com.example.Address" },
+    ]
+  })
+  normalize = true
+
+  reference {
+    name    = "com.example.Address"
+    subject = "address-value"
+    version = 1
+  }
+
+  metadata {
+    properties = {
+      owner = "team-a"
+    }
+  }
+
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
+
+resource "foxcon_schema" "message" {
+  subject_name = "message-value"
+  schema_type  = "JSON"
+  schema       = file("${path.module}/message.json")
+  hard_delete  = true
+}
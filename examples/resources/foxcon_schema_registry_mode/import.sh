@@ -0,0 +1 @@
+terraform import foxcon_schema_registry_mode.this global
\ No newline at end of file
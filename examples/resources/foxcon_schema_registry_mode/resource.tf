@@ -0,0 +1,8 @@
+resource "foxcon_schema_registry_mode" "this" {
+  rest_endpoint = "http://localhost:8081"
+  mode          = "READONLY"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
@@ -0,0 +1 @@
+terraform import foxcon_subject_alias.orders orders-value
\ No newline at end of file
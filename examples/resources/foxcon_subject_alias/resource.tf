@@ -0,0 +1,10 @@
+# Old subject name resolves to the subject of the renamed topic
+resource "foxcon_subject_alias" "orders" {
+  rest_endpoint = "http://localhost:8081"
+  subject_name  = "orders-value"
+  alias         = "orders.v2-value"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
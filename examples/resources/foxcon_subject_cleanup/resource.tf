@@ -23,8 +23,13 @@ resource "foxcon_subject_cleanup" "max_stored" {
   subject_name              = "versioned"
   cleanup_method            = "MAX_STORED_SCHEMAS"
   number_of_schemas_to_keep = 1
+  referenced_versions       = "KEEP"
   credentials {
     key    = "admin"
     secret = "admin-secret"
   }
+  timeouts {
+    create = "30m"
+    update = "30m"
+  }
 }
@@ -0,0 +1,9 @@
+resource "foxcon_subject_compatibility" "orders" {
+  rest_endpoint       = "http://localhost:8081"
+  subject_name        = "orders-value"
+  compatibility_level = "FULL_TRANSITIVE"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
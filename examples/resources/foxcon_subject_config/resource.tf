@@ -0,0 +1,27 @@
+resource "foxcon_subject_config" "orders" {
+  rest_endpoint       = "http://localhost:8081"
+  subject_name        = "orders-value"
+  compatibility_level = "BACKWARD"
+  compatibility_group = "application.major.version"
+
+  default_metadata {
+    properties = {
+      owner = "team-a"
+    }
+  }
+
+  default_rule_set = jsonencode({
+    domainRules = [{
+      name = "checkLen"
+      kind = "CONDITION"
+      type = "CEL"
+      mode = "WRITE"
+      expr = "size(message.name) < 10"
+    }]
+  })
+
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
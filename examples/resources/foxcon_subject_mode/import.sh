@@ -0,0 +1 @@
+terraform import foxcon_subject_mode.orders orders-value
\ No newline at end of file
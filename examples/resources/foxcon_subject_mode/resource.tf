@@ -0,0 +1,9 @@
+resource "foxcon_subject_mode" "orders" {
+  rest_endpoint = "http://localhost:8081"
+  subject_name  = "orders-value"
+  mode          = "READONLY"
+  credentials {
+    key    = "admin"
+    secret = "admin-secret"
+  }
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action                   = (*schemaRegistryModeAction)(nil)
+	_ action.ActionWithConfigure      = &schemaRegistryModeAction{}
+	_ action.ActionWithValidateConfig = &schemaRegistryModeAction{}
+)
+
+func SetSchemaRegistryModeAction() action.Action {
+	return &schemaRegistryModeAction{}
+}
+
+type schemaRegistryModeAction struct {
+	clients *providerClients
+}
+
+func (r *schemaRegistryModeAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.clients = clients
+}
+
+func (a *schemaRegistryModeAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config schemaRegistryModeActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (a *schemaRegistryModeAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_set_schema_registry_mode"
+}
+
+func (a *schemaRegistryModeAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a Schema Registry Mode action that sets the global mode of a Schema Registry cluster.",
+		Attributes: map[string]schema.Attribute{
+			"registry": actionRegistryAttribute(),
+			"mode": schema.StringAttribute{
+				Required:    true,
+				Description: globalModeDescription,
+				Validators: []validator.String{
+					stringvalidator.OneOf(subjectModes...),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: modeForceDescription,
+			},
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistrySecretDescription + " Terraform actions do NOT support sensitive attributes. Please keep that in mind.",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+						},
+					},
+				},
+			},
+			"tls": actionTLSBlock(),
+		},
+	}
+}
+
+func (a *schemaRegistryModeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config schemaRegistryModeActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(a.clients, &creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	err = CheckImportMode(ctx, schemaAPIClient, "", config.Mode.ValueString(), config.Force.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting global mode",
+			"Could not set global mode: "+err.Error(),
+		)
+		return
+	}
+
+	var modePayload = SubjectModeRequest{
+		Mode: config.Mode.ValueString(),
+	}
+
+	globalMode, err := SetGlobalMode(ctx, schemaAPIClient, modePayload, config.Force.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting global mode",
+			"Could not set global mode: "+err.Error(),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("\n\nSchema Registry has been set to the '%s' mode", globalMode.Mode),
+	})
+}
+
+type schemaRegistryModeActionModel struct {
+	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
+	Mode         types.String      `tfsdk:"mode"`
+	Force        types.Bool        `tfsdk:"force"`
+	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
+}
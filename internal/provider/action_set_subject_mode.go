@@ -26,7 +26,7 @@ func SetSubjectModeAction() action.Action {
 }
 
 type subjectModeAction struct {
-	client *Client
+	clients *providerClients
 }
 
 func (r *subjectModeAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
@@ -47,11 +47,11 @@ func (r *subjectModeAction) Configure(_ context.Context, req action.ConfigureReq
 		return
 	}
 
-	r.client = clients.SchemaRegistryClient
+	r.clients = clients
 }
 
 func (a *subjectModeAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
-	var config subjectModeResourceModel
+	var config subjectModeActionModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
@@ -68,6 +68,7 @@ func (a *subjectModeAction) Schema(ctx context.Context, req action.SchemaRequest
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Provides a Subject Mode action that sets Subject Mode on a Schema Registry cluster on Confluent Cloud.",
 		Attributes: map[string]schema.Attribute{
+			"registry": actionRegistryAttribute(),
 			"subject_name": schema.StringAttribute{
 				Required:    true,
 				Description: subjectNameDescription,
@@ -77,11 +78,15 @@ func (a *subjectModeAction) Schema(ctx context.Context, req action.SchemaRequest
 			},
 			"mode": schema.StringAttribute{
 				Required:    true,
-				Description: "The mode of the specified subject. Accepted values are: `READWRITE`, `READONLY`, `READONLY_OVERRIDE` and `IMPORT`.",
+				Description: subjectModeDescription,
 				Validators: []validator.String{
-					stringvalidator.OneOf("READWRITE", "READONLY", "READONLY_OVERRIDE", "IMPORT"),
+					stringvalidator.OneOf(subjectModes...),
 				},
 			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: modeForceDescription,
+			},
 			"rest_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: restEndpointDescription,
@@ -125,12 +130,13 @@ func (a *subjectModeAction) Schema(ctx context.Context, req action.SchemaRequest
 					},
 				},
 			},
+			"tls": actionTLSBlock(),
 		},
 	}
 }
 
 func (a *subjectModeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
-	var config subjectModeResourceModel
+	var config subjectModeActionModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
@@ -139,10 +145,12 @@ func (a *subjectModeAction) Invoke(ctx context.Context, req action.InvokeRequest
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
 		Credentials:  config.Credentials,
+		TLS:          config.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(a.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(a.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -151,11 +159,20 @@ func (a *subjectModeAction) Invoke(ctx context.Context, req action.InvokeRequest
 		return
 	}
 
+	err = CheckImportMode(ctx, schemaAPIClient, config.SubjectName.ValueString(), config.Mode.ValueString(), config.Force.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting subject mode",
+			"Could not set mode of subject "+config.SubjectName.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
 	var subjectModePayload = SubjectModeRequest{
 		Mode: *config.Mode.ValueStringPointer(),
 	}
 
-	subjectMode, err := SetSubjectMode(schemaAPIClient, config.SubjectName.ValueString(), subjectModePayload)
+	subjectMode, err := SetSubjectMode(ctx, schemaAPIClient, config.SubjectName.ValueString(), subjectModePayload, config.Force.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error setting normalization",
@@ -169,9 +186,12 @@ func (a *subjectModeAction) Invoke(ctx context.Context, req action.InvokeRequest
 	})
 }
 
-type subjectModeResourceModel struct {
+type subjectModeActionModel struct {
 	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
 	SubjectName  types.String      `tfsdk:"subject_name"`
 	Mode         types.String      `tfsdk:"mode"`
+	Force        types.Bool        `tfsdk:"force"`
 	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
 }
@@ -9,4 +9,16 @@ const (
 	schemaRegistryKeyDescription    = "The Schema Registry API Key."
 	schemaRegistrySecretDescription = "The Schema Registry API Secret."
 	normalizationToggleDescription  = "Normalization toggle value."
+	tlsDescription                  = "TLS settings of the Schema Registry connection. Used together with `rest_endpoint` and takes precedence over the provider TLS settings."
+	caCertificateDescription        = "CA certificate bundle used to verify the Schema Registry server certificate. Accepts a file path or PEM encoded content."
+	clientCertificateDescription    = "Client certificate used for mutual TLS. Accepts a file path or PEM encoded content."
+	clientKeyDescription            = "Client private key used for mutual TLS. Accepts a file path or PEM encoded content."
+	tlsServerNameDescription        = "Server name used to verify the Schema Registry server certificate when it differs from the rest endpoint host."
+	compatibilityLevelDescription   = "Compatibility level. Accepted values are: `NONE`, `BACKWARD`, `BACKWARD_TRANSITIVE`, `FORWARD`, `FORWARD_TRANSITIVE`, `FULL` and `FULL_TRANSITIVE`."
+	subjectModeDescription          = "The mode of the specified subject. Accepted values are: `READWRITE`, `READONLY`, `READONLY_OVERRIDE` and `IMPORT`."
+	globalModeDescription           = "The global mode. Accepted values are: `READWRITE`, `READONLY`, `READONLY_OVERRIDE` and `IMPORT`."
+	modeForceDescription            = "Allow switching to `IMPORT` mode while schema versions exist. Without it the versions are checked and the change is refused, unless the mode already is `IMPORT`."
+	contextDescription              = "Schema context of the subject, for example `.staging`, as listed by the `foxcon_contexts` data source. Defaults to the default context `.`."
+	registryDescription             = "Name of a Schema Registry cluster declared in the provider `schema_registries` map. Conflicts with `rest_endpoint` and `credentials`."
+	aliasDescription                = "Subject the subject is an alias for."
 )
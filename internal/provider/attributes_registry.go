@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func registryValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthAtLeast(1),
+		stringvalidator.ConflictsWith(
+			path.MatchRoot("rest_endpoint"),
+			path.MatchRoot("credentials"),
+		),
+	}
+}
+
+func resourceRegistryAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:    true,
+		Description: registryDescription,
+		Validators:  registryValidators(),
+	}
+}
+
+func dataSourceRegistryAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Description: registryDescription,
+		Validators:  registryValidators(),
+	}
+}
+
+func actionRegistryAttribute() actionschema.StringAttribute {
+	return actionschema.StringAttribute{
+		Optional:    true,
+		Description: registryDescription,
+		Validators:  registryValidators(),
+	}
+}
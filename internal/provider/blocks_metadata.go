@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func resourceMetadataBlock(description string) resourceschema.SingleNestedBlock {
+	return resourceschema.SingleNestedBlock{
+		Description: description,
+		Attributes: map[string]resourceschema.Attribute{
+			"properties": resourceschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Metadata properties.",
+			},
+			"tags": resourceschema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Tags assigned to schema paths.",
+			},
+			"sensitive": resourceschema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Names of the sensitive properties.",
+			},
+		},
+	}
+}
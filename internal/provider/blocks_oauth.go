@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// oauthBlock defines provider level bearer token authentication settings.
+func oauthBlock(target string, schemaRegistry bool) schema.SingleNestedBlock {
+	block := "cloud_oauth"
+	if schemaRegistry {
+		block = "schema_registry_oauth"
+	}
+
+	attributes := map[string]schema.Attribute{
+		"token_url": schema.StringAttribute{
+			Optional:    true,
+			Description: "Token endpoint of the identity provider used to request tokens with the OAuth client credentials grant.",
+			Validators: []validator.String{
+				EndpointValidator{},
+				stringvalidator.AlsoRequires(
+					path.MatchRoot(block).AtName("client_id"),
+					path.MatchRoot(block).AtName("client_secret"),
+				),
+				stringvalidator.ConflictsWith(
+					path.MatchRoot(block).AtName("static_token"),
+				),
+			},
+		},
+		"client_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "OAuth client id.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(
+					path.MatchRoot(block).AtName("token_url"),
+				),
+			},
+		},
+		"client_secret": schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			Description: "OAuth client secret.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(
+					path.MatchRoot(block).AtName("token_url"),
+				),
+			},
+		},
+		"scope": schema.StringAttribute{
+			Optional:    true,
+			Description: "Scope requested together with the token.",
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(
+					path.MatchRoot(block).AtName("token_url"),
+				),
+			},
+		},
+		"static_token": schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Static bearer token. Use it instead of the client credentials grant when the token is issued outside of Terraform.",
+		},
+		"identity_pool_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "Confluent Cloud identity pool id sent as `Confluent-Identity-Pool-Id` header.",
+		},
+	}
+
+	if schemaRegistry {
+		attributes["logical_cluster_id"] = schema.StringAttribute{
+			Optional:    true,
+			Description: "Schema Registry logical cluster id sent as `target-sr-cluster` header. Required by Confluent Cloud.",
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Description: fmt.Sprintf("Bearer token authentication for %s. Takes precedence over API key and secret. Either `static_token` or `token_url`, `client_id` and `client_secret` must be set.", target),
+		Attributes:  attributes,
+	}
+}
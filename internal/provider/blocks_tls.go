@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func tlsValidators(pair string) []validator.String {
+	validators := []validator.String{
+		stringvalidator.LengthAtLeast(1),
+		stringvalidator.AlsoRequires(
+			path.MatchRoot("rest_endpoint"),
+		),
+	}
+	if pair != "" {
+		validators = append(validators, stringvalidator.AlsoRequires(
+			path.MatchRoot("tls").AtName(pair),
+		))
+	}
+	return validators
+}
+
+func resourceTLSBlock() resourceschema.SingleNestedBlock {
+	return resourceschema.SingleNestedBlock{
+		Description: tlsDescription,
+		Attributes: map[string]resourceschema.Attribute{
+			"ca_certificate": resourceschema.StringAttribute{
+				Optional:    true,
+				Description: caCertificateDescription,
+				Validators:  tlsValidators(""),
+			},
+			"client_certificate": resourceschema.StringAttribute{
+				Optional:    true,
+				Description: clientCertificateDescription,
+				Validators:  tlsValidators("client_key"),
+			},
+			"client_key": resourceschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: clientKeyDescription,
+				Validators:  tlsValidators("client_certificate"),
+			},
+			"server_name": resourceschema.StringAttribute{
+				Optional:    true,
+				Description: tlsServerNameDescription,
+				Validators:  tlsValidators(""),
+			},
+		},
+	}
+}
+
+func dataSourceTLSBlock() datasourceschema.SingleNestedBlock {
+	return datasourceschema.SingleNestedBlock{
+		Description: tlsDescription,
+		Attributes: map[string]datasourceschema.Attribute{
+			"ca_certificate": datasourceschema.StringAttribute{
+				Optional:    true,
+				Description: caCertificateDescription,
+				Validators:  tlsValidators(""),
+			},
+			"client_certificate": datasourceschema.StringAttribute{
+				Optional:    true,
+				Description: clientCertificateDescription,
+				Validators:  tlsValidators("client_key"),
+			},
+			"client_key": datasourceschema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: clientKeyDescription,
+				Validators:  tlsValidators("client_certificate"),
+			},
+			"server_name": datasourceschema.StringAttribute{
+				Optional:    true,
+				Description: tlsServerNameDescription,
+				Validators:  tlsValidators(""),
+			},
+		},
+	}
+}
+
+func actionTLSBlock() actionschema.SingleNestedBlock {
+	return actionschema.SingleNestedBlock{
+		Description: tlsDescription,
+		Attributes: map[string]actionschema.Attribute{
+			"ca_certificate": actionschema.StringAttribute{
+				Optional:    true,
+				Description: caCertificateDescription,
+				Validators:  tlsValidators(""),
+			},
+			"client_certificate": actionschema.StringAttribute{
+				Optional:    true,
+				Description: clientCertificateDescription,
+				Validators:  tlsValidators("client_key"),
+			},
+			"client_key": actionschema.StringAttribute{
+				Optional:    true,
+				Description: clientKeyDescription + " Terraform actions do NOT support sensitive attributes. Prefer a file path over PEM content.",
+				Validators:  tlsValidators("client_certificate"),
+			},
+			"server_name": actionschema.StringAttribute{
+				Optional:    true,
+				Description: tlsServerNameDescription,
+				Validators:  tlsValidators(""),
+			},
+		},
+	}
+}
@@ -4,51 +4,313 @@
 package provider
 
 import (
+	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultMaxRetries   int           = 4
+	defaultRetryWaitMin time.Duration = 1 * time.Second
+	defaultRetryWaitMax time.Duration = 30 * time.Second
+	// defaultRequestTimeout limits every single HTTP request attempt.
+	defaultRequestTimeout time.Duration = 10 * time.Second
+	// defaultOperationTimeout limits a whole create, update or delete operation of a resource.
+	defaultOperationTimeout time.Duration = 20 * time.Minute
 )
 
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
-	Auth       AuthStruct
+	Auth       Authenticator
+	Retry      RetryPolicy
+	Limiter    *rate.Limiter
+	// PageSize of Confluent Cloud list requests
+	PageSize int
 }
 
+// AuthStruct is a basic authentication Authenticator.
 type AuthStruct struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
 }
 
-func NewClient(HostURL, username, password *string) (*Client, error) {
+// RetryPolicy controls how failed requests are retried by Client.Do.
+type RetryPolicy struct {
+	MaxRetries int
+	WaitMin    time.Duration
+	WaitMax    time.Duration
+}
+
+// TLSOptions configures the TLS connection of https endpoints. Certificates
+// and key can be set either as a file path or as PEM encoded content.
+type TLSOptions struct {
+	CACertificate     string
+	ClientCertificate string
+	ClientKey         string
+	ServerName        string
+}
+
+// ClientOptions holds provider level settings shared by every client the provider creates.
+type ClientOptions struct {
+	Retry    RetryPolicy
+	TLS      TLSOptions
+	Timeout  time.Duration
+	Limiters *RateLimiters
+	PageSize int
+}
+
+func DefaultClientOptions() ClientOptions {
+	return ClientOptions{
+		Retry: RetryPolicy{
+			MaxRetries: defaultMaxRetries,
+			WaitMin:    defaultRetryWaitMin,
+			WaitMax:    defaultRetryWaitMax,
+		},
+		Timeout:  defaultRequestTimeout,
+		PageSize: defaultPageSize,
+	}
+}
+
+func NewClient(HostURL *string, auth Authenticator, options ClientOptions) (*Client, error) {
 	transport := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 	}
 
 	if strings.HasPrefix(*HostURL, "https") {
-		transport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: false,
+		tlsConfig, err := options.TLS.config()
+		if err != nil {
+			return nil, err
 		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = defaultRequestTimeout
 	}
 
 	c := Client{
 		HTTPClient: &http.Client{
-			Timeout:   10 * time.Second,
-			Transport: transport,
+			Timeout:   timeout,
+			Transport: newLoggingTransport(transport, auth),
 		},
-		HostURL: *HostURL,
+		HostURL:  *HostURL,
+		Auth:     auth,
+		Retry:    options.Retry,
+		Limiter:  options.Limiters.For(*HostURL),
+		PageSize: options.PageSize,
 	}
 
-	// If username or password not provided, return empty client
-	if username == nil || password == nil {
-		return &c, nil
+	return &c, nil
+}
+
+func (o TLSOptions) config() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: false,
+		ServerName:         o.ServerName,
 	}
 
-	c.Auth = AuthStruct{
-		Username: *username,
-		Password: *password,
+	if o.CACertificate != "" {
+		caPEM, err := readPEM(o.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA certificate: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("could not parse CA certificate: no PEM encoded certificates found")
+		}
+		tlsConfig.RootCAs = pool
 	}
 
-	return &c, nil
+	if o.ClientCertificate != "" || o.ClientKey != "" {
+		if o.ClientCertificate == "" || o.ClientKey == "" {
+			return nil, fmt.Errorf("both client certificate and client key must be set to use mutual TLS")
+		}
+
+		certPEM, err := readPEM(o.ClientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("could not read client certificate: %w", err)
+		}
+
+		keyPEM, err := readPEM(o.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not read client key: %w", err)
+		}
+
+		certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate and key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEM returns the value itself when it contains PEM content, otherwise reads it as a file path.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// Do authenticates and sends the request. Every attempt waits for the client rate limiter.
+// Request is retried on rate limiting, gateway errors and connection failures
+// according to the client retry policy.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	tokenRefreshed := false
+
+	for attempt := 0; ; attempt++ {
+		if (attempt > 0 || tokenRefreshed) && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		if c.Limiter != nil {
+			if err := c.Limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		if c.Auth != nil {
+			if err := c.Auth.Authenticate(req.Context(), req); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := c.HTTPClient.Do(req)
+
+		// Cached token might have been revoked before its expiration. Fetch a new one once.
+		if err == nil && res.StatusCode == http.StatusUnauthorized && !tokenRefreshed && canReplay(req) {
+			if invalidator, ok := c.Auth.(tokenInvalidator); ok {
+				invalidator.Invalidate()
+				tokenRefreshed = true
+				_, _ = io.Copy(io.Discard, res.Body)
+				res.Body.Close()
+				attempt--
+				continue
+			}
+		}
+
+		if attempt >= c.Retry.MaxRetries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		wait := c.Retry.backoff(attempt, res)
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	// Request body can not be replayed
+	if !canReplay(req) {
+		return false
+	}
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		var certErr *tls.CertificateVerificationError
+		if errors.As(err, &certErr) {
+			return false
+		}
+		// Connection failures are only safe to repeat for idempotent requests
+		return isIdempotent(req.Method)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		// Rate limited requests have not been processed by the server
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns the wait time before the next attempt. Retry-After header
+// takes precedence over the exponential backoff with jitter.
+func (p RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, p.WaitMax)
+		}
+	}
+
+	wait := p.WaitMin << attempt
+	if wait <= 0 || wait > p.WaitMax {
+		wait = p.WaitMax
+	}
+
+	// Equal jitter: half of the window is fixed, the other half is random
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+	return half + rand.N(half+1)
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
 }
@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// tokenExpiryDelta refreshes OAuth tokens ahead of their expiration.
+	tokenExpiryDelta time.Duration = 30 * time.Second
+	// defaultTokenLifetime is used when token endpoint does not return expires_in.
+	defaultTokenLifetime time.Duration = 5 * time.Minute
+)
+
+// Authenticator adds credentials to every request sent by Client.
+type Authenticator interface {
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// tokenInvalidator is implemented by authenticators that cache tokens which
+// can be rejected by the server before they expire.
+type tokenInvalidator interface {
+	Invalidate()
+}
+
+// secretHolder is implemented by authenticators holding values that must never be logged.
+type secretHolder interface {
+	secrets() []string
+}
+
+// Authenticate sets basic authentication credentials on the request.
+func (a AuthStruct) Authenticate(_ context.Context, req *http.Request) error {
+	req.SetBasicAuth(a.Username, a.Password)
+	return nil
+}
+
+func (a AuthStruct) secrets() []string {
+	return []string{a.Password}
+}
+
+// IdentityHeaders are Confluent specific headers sent together with a bearer token.
+type IdentityHeaders struct {
+	// IdentityPoolID is sent as `Confluent-Identity-Pool-Id` header
+	IdentityPoolID string
+	// LogicalClusterID is sent as `target-sr-cluster` header
+	LogicalClusterID string
+}
+
+func (h IdentityHeaders) set(req *http.Request) {
+	if h.IdentityPoolID != "" {
+		req.Header.Set("Confluent-Identity-Pool-Id", h.IdentityPoolID)
+	}
+	if h.LogicalClusterID != "" {
+		req.Header.Set("target-sr-cluster", h.LogicalClusterID)
+	}
+}
+
+// BearerTokenAuthenticator sends a static bearer token.
+type BearerTokenAuthenticator struct {
+	Token   string
+	Headers IdentityHeaders
+}
+
+func (a *BearerTokenAuthenticator) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	a.Headers.set(req)
+	return nil
+}
+
+func (a *BearerTokenAuthenticator) secrets() []string {
+	return []string{a.Token}
+}
+
+// OAuthClientCredentialsAuthenticator fetches bearer tokens from an identity
+// provider using the OAuth client credentials grant and caches them until expiration.
+type OAuthClientCredentialsAuthenticator struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scope        string
+	Headers      IdentityHeaders
+	HTTPClient   *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func (a *OAuthClientCredentialsAuthenticator) Authenticate(ctx context.Context, req *http.Request) error {
+	token, err := a.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	a.Headers.set(req)
+	return nil
+}
+
+func (a *OAuthClientCredentialsAuthenticator) secrets() []string {
+	return []string{a.ClientSecret}
+}
+
+// Invalidate drops the cached token so the next request fetches a new one.
+func (a *OAuthClientCredentialsAuthenticator) Invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.token = ""
+	a.expiry = time.Time{}
+}
+
+// Token returns the cached token or requests a new one when it is about to expire.
+func (a *OAuthClientCredentialsAuthenticator) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && time.Now().Add(tokenExpiryDelta).Before(a.expiry) {
+		return a.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if a.Scope != "" {
+		form.Set("scope", a.Scope)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", a.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(a.ClientID), url.QueryEscape(a.ClientSecret))
+
+	httpClient := a.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not request OAuth token: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", newAPIError(res, "failed to request OAuth token")
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var token oauthTokenResponse
+	if err = json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("could not decode OAuth token response: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("OAuth token response does not contain an access token")
+	}
+
+	lifetime := defaultTokenLifetime
+	if token.ExpiresIn > 0 {
+		lifetime = time.Duration(token.ExpiresIn) * time.Second
+	}
+
+	a.token = token.AccessToken
+	a.expiry = time.Now().Add(lifetime)
+
+	return a.token, nil
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newTokenServer(t *testing.T, expiresIn int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var issued atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, issued.Add(1), expiresIn)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &issued
+}
+
+func TestOAuthClientCredentialsTokenIsCached(t *testing.T) {
+	tokenServer, issued := newTokenServer(t, 3600)
+
+	var headers atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers.Store(r.Header.Clone())
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.Auth = &OAuthClientCredentialsAuthenticator{
+		TokenURL:     tokenServer.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Headers: IdentityHeaders{
+			IdentityPoolID:   "pool-1",
+			LogicalClusterID: "lsrc-1",
+		},
+	}
+
+	for range 3 {
+		req, _ := http.NewRequest("GET", server.URL+"/subjects", nil)
+		res, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+	}
+
+	if issued.Load() != 1 {
+		t.Fatalf("unexpected number of issued tokens: got %d, want %d", issued.Load(), 1)
+	}
+
+	header := headers.Load().(http.Header)
+	if header.Get("Authorization") != "Bearer token-1" {
+		t.Fatalf("unexpected Authorization header: %s", header.Get("Authorization"))
+	}
+	if header.Get("Confluent-Identity-Pool-Id") != "pool-1" || header.Get("target-sr-cluster") != "lsrc-1" {
+		t.Fatalf("unexpected identity headers: %v", header)
+	}
+}
+
+func TestOAuthClientCredentialsTokenRefreshedBeforeExpiry(t *testing.T) {
+	// Tokens expiring within tokenExpiryDelta are never reused
+	tokenServer, issued := newTokenServer(t, 1)
+
+	auth := &OAuthClientCredentialsAuthenticator{
+		TokenURL:     tokenServer.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+	}
+
+	for range 2 {
+		if _, err := auth.Token(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if issued.Load() != 2 {
+		t.Fatalf("unexpected number of issued tokens: got %d, want %d", issued.Load(), 2)
+	}
+}
+
+func TestOAuthClientCredentialsTokenRefreshedOnUnauthorized(t *testing.T) {
+	tokenServer, issued := newTokenServer(t, 3600)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// First token is revoked by the server
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.Auth = &OAuthClientCredentialsAuthenticator{
+		TokenURL:     tokenServer.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+	}
+
+	req, _ := http.NewRequest("PUT", server.URL+"/config/test", strings.NewReader(`{"normalize":true}`))
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: got %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	if issued.Load() != 2 {
+		t.Fatalf("unexpected number of issued tokens: got %d, want %d", issued.Load(), 2)
+	}
+}
+
+func TestOAuthClientCredentialsInvalidClient(t *testing.T) {
+	tokenServer, _ := newTokenServer(t, 3600)
+
+	auth := &OAuthClientCredentialsAuthenticator{
+		TokenURL:     tokenServer.URL,
+		ClientID:     "client",
+		ClientSecret: "wrong",
+	}
+
+	if _, err := auth.Token(t.Context()); err == nil {
+		t.Fatal("expected error on invalid client credentials, got nil")
+	}
+}
+
+func TestBearerTokenAuthenticator(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer static" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.Auth = &BearerTokenAuthenticator{Token: "static"}
+
+	req, _ := http.NewRequest("GET", server.URL+"/subjects", nil)
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: got %d, want %d", res.StatusCode, http.StatusOK)
+	}
+}
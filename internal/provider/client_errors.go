@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Error kinds returned by the client. Use errors.Is to check an error against them.
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrConflict      = errors.New("conflict")
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrRateLimited   = errors.New("rate limited")
+	ErrInvalidSchema = errors.New("invalid schema")
+	ErrInvalidInput  = errors.New("invalid input")
+)
+
+// Schema Registry error codes that need special handling.
+const (
+	srErrorCodeIncompatibleSchema = 409
+	srErrorCodeInvalidSchema      = 42201
+	srErrorCodeReferenceExists    = 42206
+)
+
+// maxErrorBodySize limits how much of an error response body is read.
+const maxErrorBodySize = 64 * 1024
+
+// APIError is an error response received from Schema Registry or Confluent Cloud API.
+type APIError struct {
+	Operation  string
+	StatusCode int
+	// ErrorCode is the Schema Registry `error_code` or Confluent Cloud error `code`
+	ErrorCode string
+	Message   string
+	Kind      error
+}
+
+// schemaRegistryErrorBody is the error payload returned by Schema Registry.
+type schemaRegistryErrorBody struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// cloudErrorBody is the error payload returned by Confluent Cloud APIs.
+type cloudErrorBody struct {
+	Errors []struct {
+		Status string `json:"status"`
+		Code   string `json:"code"`
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	} `json:"errors"`
+}
+
+// newAPIError builds an APIError out of a non successful response. Response body is consumed.
+func newAPIError(res *http.Response, operation string) *APIError {
+	apiErr := &APIError{
+		Operation:  operation,
+		StatusCode: res.StatusCode,
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+	apiErr.parseBody(body)
+	apiErr.Kind = apiErr.classify()
+
+	return apiErr
+}
+
+func (e *APIError) parseBody(body []byte) {
+	if len(body) == 0 {
+		return
+	}
+
+	var srBody schemaRegistryErrorBody
+	if err := json.Unmarshal(body, &srBody); err == nil && (srBody.ErrorCode != 0 || srBody.Message != "") {
+		if srBody.ErrorCode != 0 {
+			e.ErrorCode = fmt.Sprintf("%d", srBody.ErrorCode)
+		}
+		e.Message = srBody.Message
+		return
+	}
+
+	var cloudBody cloudErrorBody
+	if err := json.Unmarshal(body, &cloudBody); err == nil && len(cloudBody.Errors) > 0 {
+		var details []string
+		for _, item := range cloudBody.Errors {
+			switch {
+			case item.Detail != "":
+				details = append(details, item.Detail)
+			case item.Title != "":
+				details = append(details, item.Title)
+			}
+		}
+		e.ErrorCode = cloudBody.Errors[0].Code
+		e.Message = strings.Join(details, "; ")
+		return
+	}
+
+	// Not a known error payload, keep it as is
+	e.Message = strings.TrimSpace(string(body))
+}
+
+func (e *APIError) classify() error {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		if e.ErrorCode == fmt.Sprintf("%d", srErrorCodeIncompatibleSchema) {
+			return ErrInvalidSchema
+		}
+		return ErrConflict
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusUnprocessableEntity, http.StatusBadRequest:
+		if e.ErrorCode == fmt.Sprintf("%d", srErrorCodeInvalidSchema) {
+			return ErrInvalidSchema
+		}
+		return ErrInvalidInput
+	}
+	return nil
+}
+
+// Hint returns a remediation hint for the error kind.
+func (e *APIError) Hint() string {
+	switch e.Kind {
+	case ErrNotFound:
+		return "Make sure the object exists and the rest endpoint points to the expected Schema Registry cluster or Confluent organization."
+	case ErrConflict:
+		return "The request conflicts with the current state of the object. Refresh the state and make sure the object is not managed elsewhere."
+	case ErrUnauthorized:
+		return "Make sure the API key and secret are valid and have permissions for this operation."
+	case ErrRateLimited:
+		return "Request quota has been exceeded. Reduce Terraform parallelism or increase max_retries and max_retry_wait_seconds in the provider settings."
+	case ErrInvalidSchema:
+		return "Make sure the schema is valid for its schema type and compatible with the compatibility level of the subject."
+	case ErrInvalidInput:
+		return "Make sure the configured values are accepted by the server."
+	}
+	return ""
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s. Response code %d", e.Operation, e.StatusCode)
+	if e.ErrorCode != "" {
+		fmt.Fprintf(&b, " (error code %s)", e.ErrorCode)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", strings.TrimSuffix(e.Message, "."))
+	}
+	if hint := e.Hint(); hint != "" {
+		fmt.Fprintf(&b, ". %s", hint)
+	}
+
+	return b.String()
+}
+
+// Is reports whether the error is of the target kind.
+func (e *APIError) Is(target error) bool {
+	return e.Kind != nil && e.Kind == target
+}
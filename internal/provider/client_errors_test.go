@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newErrorResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestAPIErrorSchemaRegistryBody(t *testing.T) {
+	err := newAPIError(newErrorResponse(422, `{"error_code":42203,"message":"Invalid compatibility level"}`), "failed to update subject 'test' configuration")
+
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("unexpected error kind: %v", err.Kind)
+	}
+
+	expected := "failed to update subject 'test' configuration. Response code 422 (error code 42203): Invalid compatibility level. " + err.Hint()
+	if err.Error() != expected {
+		t.Fatalf("unexpected error message: got '%s', want '%s'", err.Error(), expected)
+	}
+}
+
+func TestAPIErrorSchemaRegistryInvalidSchema(t *testing.T) {
+	err := newAPIError(newErrorResponse(422, `{"error_code":42201,"message":"Either the input schema or one of its references is invalid"}`), "failed to register schema")
+	if !errors.Is(err, ErrInvalidSchema) {
+		t.Fatalf("unexpected error kind: %v", err.Kind)
+	}
+
+	err = newAPIError(newErrorResponse(409, `{"error_code":409,"message":"Schema being registered is incompatible with an earlier schema"}`), "failed to register schema")
+	if !errors.Is(err, ErrInvalidSchema) {
+		t.Fatalf("unexpected error kind: %v", err.Kind)
+	}
+}
+
+func TestAPIErrorCloudBody(t *testing.T) {
+	err := newAPIError(newErrorResponse(409, `{"errors":[{"status":"409","code":"conflict","detail":"Invitation already exists"}]}`), "failed to create invitation")
+
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("unexpected error kind: %v", err.Kind)
+	}
+	if err.ErrorCode != "conflict" || err.Message != "Invitation already exists" {
+		t.Fatalf("unexpected error details: code '%s', message '%s'", err.ErrorCode, err.Message)
+	}
+}
+
+func TestAPIErrorKinds(t *testing.T) {
+	cases := map[int]error{
+		401: ErrUnauthorized,
+		403: ErrUnauthorized,
+		404: ErrNotFound,
+		429: ErrRateLimited,
+	}
+
+	for statusCode, kind := range cases {
+		err := newAPIError(newErrorResponse(statusCode, ""), "request failed")
+		if !errors.Is(err, kind) {
+			t.Fatalf("unexpected error kind for %d: got %v, want %v", statusCode, err.Kind, kind)
+		}
+	}
+
+	err := newAPIError(newErrorResponse(500, "Internal Server Error"), "request failed")
+	if err.Kind != nil || err.Message != "Internal Server Error" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
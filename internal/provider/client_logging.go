@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// maxLoggedBodySize limits request and response bodies written to trace logs.
+	maxLoggedBodySize int = 4096
+	redactedValue         = "[REDACTED]"
+)
+
+// sensitiveBodyFields matches credentials carried in request and response bodies, like the user info of
+// the destination registry in an exporter config.
+var sensitiveBodyFields = regexp.MustCompile(`"basic\.auth\.user\.info"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+// loggingTransport writes requests and responses to provider trace logs.
+// Authorization headers, client secrets and credentials in bodies are redacted.
+type loggingTransport struct {
+	next    http.RoundTripper
+	secrets []string
+}
+
+func newLoggingTransport(next http.RoundTripper, auth Authenticator) *loggingTransport {
+	var secrets []string
+	if holder, ok := auth.(secretHolder); ok {
+		for _, secret := range holder.secrets() {
+			if secret != "" {
+				secrets = append(secrets, secret)
+			}
+		}
+	}
+
+	return &loggingTransport{
+		next:    next,
+		secrets: secrets,
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.MaskAllFieldValuesRegexes(req.Context(), sensitiveBodyFields)
+	if len(t.secrets) > 0 {
+		ctx = tflog.MaskLogStrings(ctx, t.secrets...)
+	}
+
+	tflog.Trace(ctx, "Sending HTTP request", map[string]any{
+		"http_method":          req.Method,
+		"http_url":             req.URL.String(),
+		"http_request_headers": redactHeaders(req.Header),
+		"http_request_body":    requestBody(req),
+	})
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	if err != nil {
+		tflog.Trace(ctx, "HTTP request failed", map[string]any{
+			"http_method":     req.Method,
+			"http_url":        req.URL.String(),
+			"http_latency_ms": latency.Milliseconds(),
+			"error":           err.Error(),
+		})
+		return res, err
+	}
+
+	tflog.Trace(ctx, "Received HTTP response", map[string]any{
+		"http_method":           req.Method,
+		"http_url":              req.URL.String(),
+		"http_status":           res.StatusCode,
+		"http_latency_ms":       latency.Milliseconds(),
+		"http_response_headers": redactHeaders(res.Header),
+		"http_response_body":    responseBody(res),
+	})
+
+	return res, nil
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for key, values := range header {
+		switch http.CanonicalHeaderKey(key) {
+		case "Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie":
+			headers[key] = redactedValue
+		default:
+			headers[key] = strings.Join(values, ", ")
+		}
+	}
+	return headers
+}
+
+// requestBody returns the truncated request body without consuming it.
+func requestBody(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody {
+		return ""
+	}
+	if req.GetBody == nil {
+		return "<body not logged>"
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "<body not logged>"
+	}
+	defer body.Close()
+
+	return readTruncated(body)
+}
+
+// responseBody returns the truncated response body and puts the consumed part back.
+func responseBody(res *http.Response) string {
+	if res.Body == nil || res.Body == http.NoBody {
+		return ""
+	}
+
+	prefix, err := io.ReadAll(io.LimitReader(res.Body, int64(maxLoggedBodySize)+1))
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), res.Body), res.Body}
+	if err != nil {
+		return "<body not logged>"
+	}
+
+	return truncate(prefix)
+}
+
+func readTruncated(r io.Reader) string {
+	body, err := io.ReadAll(io.LimitReader(r, int64(maxLoggedBodySize)+1))
+	if err != nil {
+		return "<body not logged>"
+	}
+	return truncate(body)
+}
+
+func truncate(body []byte) string {
+	if len(body) > maxLoggedBodySize {
+		return string(body[:maxLoggedBodySize]) + "...(truncated)"
+	}
+	return string(body)
+}
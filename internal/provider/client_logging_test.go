@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestClientTraceLogging(t *testing.T) {
+	secret := "resource-level-secret"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"compatibilityLevel":"BACKWARD","normalize":true}`))
+	}))
+	defer server.Close()
+
+	options := DefaultClientOptions()
+	client, err := NewClient(&server.URL, AuthStruct{Username: "resource-level-key", Password: secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, _ := http.NewRequestWithContext(ctx, "PUT", server.URL+"/config/test", strings.NewReader(`{"normalize":true,"echo":"`+secret+`"}`))
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	// Response body must still be readable after logging
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != `{"compatibilityLevel":"BACKWARD","normalize":true}` {
+		t.Fatalf("unexpected response body: %s", body)
+	}
+
+	logs := output.String()
+
+	for _, expected := range []string{"Sending HTTP request", "Received HTTP response", `"http_method":"PUT"`, `"http_status":200`, "http_latency_ms", "compatibilityLevel", "[REDACTED]"} {
+		if !strings.Contains(logs, expected) {
+			t.Fatalf("expected logs to contain %s, got: %s", expected, logs)
+		}
+	}
+
+	basicAuth := base64.StdEncoding.EncodeToString([]byte("resource-level-key:" + secret))
+	for _, leaked := range []string{secret, basicAuth} {
+		if strings.Contains(logs, leaked) {
+			t.Fatalf("expected logs not to contain %s, got: %s", leaked, logs)
+		}
+	}
+}
+
+func TestClientTraceLoggingBodyCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"schema.registry.url":"https://destination","basic.auth.user.info":"destination-key:destination-secret"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: "key", Password: "secret"}, DefaultClientOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	payload := `{"name":"exporter","config":{"basic.auth.credentials.source":"USER_INFO","basic.auth.user.info": "destination-key:destination-secret"}}`
+	req, _ := http.NewRequestWithContext(ctx, "POST", server.URL+"/exporters", strings.NewReader(payload))
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	logs := output.String()
+
+	if !strings.Contains(logs, "https://destination") {
+		t.Fatalf("expected logs to contain the response body, got: %s", logs)
+	}
+	if strings.Contains(logs, "destination-secret") {
+		t.Fatalf("expected logs not to contain the destination secret, got: %s", logs)
+	}
+}
+
+func TestTruncateLoggedBody(t *testing.T) {
+	body := bytes.Repeat([]byte("a"), maxLoggedBodySize+10)
+
+	truncated := truncate(body)
+	if !strings.HasSuffix(truncated, "...(truncated)") || len(truncated) != maxLoggedBodySize+len("...(truncated)") {
+		t.Fatalf("unexpected truncated body length: %d", len(truncated))
+	}
+}
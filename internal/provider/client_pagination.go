@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultPageSize is the maximum page size accepted by Confluent Cloud list endpoints.
+	defaultPageSize int = 100
+)
+
+// Paginator walks a Confluent Cloud list endpoint page by page following ListMeta.Next.
+type Paginator[T any] struct {
+	client    *Client
+	operation string
+	next      string
+	visited   map[string]bool
+}
+
+// NewPaginator returns a paginator over the list endpoint at path. Page size
+// defaults to the client page size when pageSize is not positive.
+func NewPaginator[T any](client *Client, path string, query url.Values, pageSize int, operation string) *Paginator[T] {
+	if pageSize <= 0 {
+		pageSize = client.PageSize
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	params := url.Values{}
+	for key, values := range query {
+		params[key] = values
+	}
+	params.Set("page_size", strconv.Itoa(pageSize))
+
+	return &Paginator[T]{
+		client:    client,
+		operation: operation,
+		next:      fmt.Sprintf("%s%s?%s", client.HostURL, path, params.Encode()),
+		visited:   map[string]bool{},
+	}
+}
+
+// HasMorePages reports whether NextPage has anything left to fetch.
+func (p *Paginator[T]) HasMorePages() bool {
+	return p.next != ""
+}
+
+// NextPage fetches the next page of items.
+func (p *Paginator[T]) NextPage(ctx context.Context) ([]T, error) {
+	if p.next == "" {
+		return nil, nil
+	}
+
+	// Guard against endpoints returning the same page over and over again
+	if p.visited[p.next] {
+		return nil, fmt.Errorf("%s: page '%s' was already visited", p.operation, p.next)
+	}
+	p.visited[p.next] = true
+
+	req, err := http.NewRequestWithContext(ctx, "GET", p.next, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, p.operation)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var page ListPage[T]
+	err = json.Unmarshal(body, &page)
+	if err != nil {
+		return nil, err
+	}
+
+	p.next = p.resolve(page.Metadata.Next)
+
+	return page.Data, nil
+}
+
+// resolve turns relative next page links into absolute ones.
+func (p *Paginator[T]) resolve(next string) string {
+	if next == "" || strings.HasPrefix(next, "http://") || strings.HasPrefix(next, "https://") {
+		return next
+	}
+	return strings.TrimRight(p.client.HostURL, "/") + "/" + strings.TrimLeft(next, "/")
+}
+
+// Find returns the first item matching the predicate, fetching only as many pages as needed.
+func (p *Paginator[T]) Find(ctx context.Context, match func(T) bool) (*T, error) {
+	for p.HasMorePages() {
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range items {
+			if match(items[i]) {
+				return &items[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// All returns items of every page.
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for p.HasMorePages() {
+		items, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// newInvitationsServer serves invitations in pages of two following ListMeta.Next.
+func newInvitationsServer(t *testing.T, invitations []Invitation) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var pages atomic.Int32
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page_size") != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		page := 0
+		if token := r.URL.Query().Get("page_token"); token != "" {
+			_, _ = fmt.Sscanf(token, "page-%d", &page)
+		}
+		pages.Add(1)
+
+		start, end := page*2, min(page*2+2, len(invitations))
+		list := ListPage[Invitation]{
+			Kind: "InvitationList",
+			Data: invitations[start:end],
+		}
+		if end < len(invitations) {
+			list.Metadata.Next = fmt.Sprintf("%s/iam/v2/invitations?page_size=2&page_token=page-%d", server.URL, page+1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(list)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &pages
+}
+
+func TestGetUserInvitationByParameterExactMatch(t *testing.T) {
+	invitations := []Invitation{
+		{ID: "i-1", Email: "john.doe.jr@example.com", User: UserEntity{ID: "u-11"}},
+		{ID: "i-2", Email: "jane@example.com", User: UserEntity{ID: "u-2"}},
+		{ID: "i-3", Email: "bob@example.com", User: UserEntity{ID: "u-3"}},
+		{ID: "i-4", Email: "John.Doe@example.com", User: UserEntity{ID: "u-1"}},
+		{ID: "i-5", Email: "alice@example.com", User: UserEntity{ID: "u-5"}},
+	}
+
+	server, pages := newInvitationsServer(t, invitations)
+
+	client := newTestClient(t, server.URL)
+	client.PageSize = 2
+
+	invitation, err := client.GetUserInvitationByParameter(t.Context(), "email", "john.doe@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invitation == nil || invitation.ID != "i-4" {
+		t.Fatalf("unexpected invitation: %+v", invitation)
+	}
+	if pages.Load() != 2 {
+		t.Fatalf("unexpected number of fetched pages: got %d, want %d", pages.Load(), 2)
+	}
+
+	invitation, err = client.GetUserInvitationByParameter(t.Context(), "user", "u-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invitation == nil || invitation.ID != "i-5" {
+		t.Fatalf("unexpected invitation: %+v", invitation)
+	}
+
+	invitation, err = client.GetUserInvitationByParameter(t.Context(), "user", "u-404")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invitation != nil {
+		t.Fatalf("expected no invitation, got: %+v", invitation)
+	}
+}
+
+func TestPaginatorAll(t *testing.T) {
+	invitations := []Invitation{{ID: "i-1"}, {ID: "i-2"}, {ID: "i-3"}}
+
+	server, pages := newInvitationsServer(t, invitations)
+
+	client := newTestClient(t, server.URL)
+
+	all, err := NewPaginator[Invitation](client, "/iam/v2/invitations", nil, 2, "failed to list invitations").All(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 || pages.Load() != 2 {
+		t.Fatalf("unexpected result: %d invitations from %d pages", len(all), pages.Load())
+	}
+}
+
+func TestPaginatorStopsOnRepeatedPage(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		list := ListPage[Invitation]{Data: []Invitation{{ID: "i-1"}}}
+		list.Metadata.Next = server.URL + "/iam/v2/invitations?page_size=100"
+		_ = json.NewEncoder(w).Encode(list)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	if _, err := NewPaginator[Invitation](client, "/iam/v2/invitations", nil, 0, "failed to list invitations").All(t.Context()); err == nil {
+		t.Fatal("expected error on repeated page, got nil")
+	}
+}
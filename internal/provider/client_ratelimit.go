@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiters hands out a token bucket per endpoint, so every client sending
+// requests to the same endpoint shares a single request budget.
+type RateLimiters struct {
+	mu       sync.Mutex
+	limit    rate.Limit
+	burst    int
+	limiters map[string]*rate.Limiter
+}
+
+// NewRateLimiters returns rate limiters allowing requestsPerSecond requests per endpoint.
+// Bursts are limited to a single second worth of requests. Zero disables rate limiting.
+func NewRateLimiters(requestsPerSecond float64) *RateLimiters {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+
+	return &RateLimiters{
+		limit:    rate.Limit(requestsPerSecond),
+		burst:    max(int(math.Ceil(requestsPerSecond)), 1),
+		limiters: map[string]*rate.Limiter{},
+	}
+}
+
+// For returns the limiter shared by all clients of the endpoint, or nil when rate limiting is disabled.
+func (l *RateLimiters) For(endpoint string) *rate.Limiter {
+	if l == nil {
+		return nil
+	}
+
+	key := endpointKey(endpoint)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// endpointKey normalizes the endpoint to scheme and host, so paths and
+// trailing slashes do not split the request budget.
+func endpointKey(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return strings.TrimRight(strings.ToLower(endpoint), "/")
+	}
+	return strings.ToLower(u.Scheme + "://" + u.Host)
+}
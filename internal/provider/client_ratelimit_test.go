@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRateLimitersSharedPerEndpoint(t *testing.T) {
+	limiters := NewRateLimiters(5)
+
+	if limiters.For("https://psrc-abcde.confluent.cloud") != limiters.For("https://PSRC-abcde.confluent.cloud/") {
+		t.Fatal("expected the same limiter for the same endpoint")
+	}
+	if limiters.For("https://psrc-abcde.confluent.cloud") == limiters.For("https://api.confluent.cloud") {
+		t.Fatal("expected different limiters for different endpoints")
+	}
+
+	if NewRateLimiters(0).For("https://api.confluent.cloud") != nil {
+		t.Fatal("expected rate limiting to be disabled")
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	options := DefaultClientOptions()
+	options.Limiters = NewRateLimiters(10)
+
+	// Clients of the same endpoint share a single bucket of 10 tokens refilled every 100ms
+	first, err := NewClient(&server.URL, AuthStruct{Username: api_key, Password: api_secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := NewClient(&server.URL, AuthStruct{Username: api_key, Password: api_secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	for i := range 14 {
+		client := first
+		if i%2 == 1 {
+			client = second
+		}
+
+		req, _ := http.NewRequest("GET", server.URL+"/subjects", nil)
+		res, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed < 300*time.Millisecond {
+		t.Fatalf("expected requests to be rate limited, took %s", elapsed)
+	}
+}
+
+func TestSchemaRegistryClientFactorySharesRateLimiter(t *testing.T) {
+	endpoint := "http://localhost:8081"
+
+	options := DefaultClientOptions()
+	options.Limiters = NewRateLimiters(10)
+
+	providerClient, err := NewClient(&endpoint, AuthStruct{Username: api_key, Password: api_secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clients := &providerClients{
+		SchemaRegistryClient: providerClient,
+		Options:              options,
+	}
+
+	resourceClient, err := schemaRegistryClientFactory(clients, &schemaRegistryCredentials{
+		RestEndpoint: types.StringValue(endpoint + "/"),
+		Credentials: &credentialsModel{
+			Key:    types.StringValue("resource-key"),
+			Secret: types.StringValue("resource-secret"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resourceClient == providerClient {
+		t.Fatal("expected a resource level client")
+	}
+	if resourceClient.Limiter == nil || resourceClient.Limiter != providerClient.Limiter {
+		t.Fatal("expected resource level client to share the provider rate limiter")
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, url string) *Client {
+	t.Helper()
+
+	options := DefaultClientOptions()
+	options.Retry.WaitMin = time.Millisecond
+	options.Retry.WaitMax = 10 * time.Millisecond
+
+	client, err := NewClient(&url, AuthStruct{Username: api_key, Password: api_secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestClientRetriesIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	req, _ := http.NewRequest("PUT", server.URL+"/config/test", strings.NewReader(`{"normalize":true}`))
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: got %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("unexpected number of calls: got %d, want %d", calls.Load(), 3)
+	}
+}
+
+func TestClientDoesNotRetryNonIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	req, _ := http.NewRequest("POST", server.URL+"/iam/v2/invitations", strings.NewReader(`{}`))
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if calls.Load() != 1 {
+		t.Fatalf("unexpected number of calls: got %d, want %d", calls.Load(), 1)
+	}
+}
+
+func TestClientRetriesRateLimitedRequests(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	req, _ := http.NewRequest("POST", server.URL+"/iam/v2/invitations", strings.NewReader(`{}`))
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: got %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("unexpected number of calls: got %d, want %d", calls.Load(), 2)
+	}
+}
+
+func TestClientStopsAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.Retry.MaxRetries = 2
+
+	req, _ := http.NewRequest("GET", server.URL+"/subjects", nil)
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("unexpected status code: got %d, want %d", res.StatusCode, http.StatusTooManyRequests)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("unexpected number of calls: got %d, want %d", calls.Load(), 3)
+	}
+}
+
+func TestClientStopsRetryingOnCancelledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	client.Retry.WaitMin = time.Minute
+	client.Retry.WaitMax = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/subjects", nil)
+	_, err := client.Do(req)
+	if err == nil {
+		t.Fatal("expected context error, got nil")
+	}
+}
+
+func TestClientRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	options := DefaultClientOptions()
+	options.Retry.MaxRetries = 0
+	options.Timeout = 50 * time.Millisecond
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: api_key, Password: api_secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest("GET", server.URL+"/subjects", nil)
+	if _, err = client.Do(req); err == nil {
+		t.Fatal("expected timeout error, got nil")
+	}
+
+	options.Timeout = time.Second
+
+	client, err = NewClient(&server.URL, AuthStruct{Username: api_key, Password: api_secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ = http.NewRequest("GET", server.URL+"/subjects", nil)
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if wait, ok := parseRetryAfter("3"); !ok || wait != 3*time.Second {
+		t.Fatalf("unexpected wait: got %s", wait)
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Fatal("expected invalid Retry-After value to be ignored")
+	}
+	if _, ok := parseRetryAfter(""); ok {
+		t.Fatal("expected empty Retry-After value to be ignored")
+	}
+}
+
+func generateClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "foxcon-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM)
+}
+
+func TestClientCustomCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	// CA certificate set as a file path
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	options := DefaultClientOptions()
+	options.Retry.MaxRetries = 0
+	options.TLS.CACertificate = caFile
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: api_key, Password: api_secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	// Default trust store does not include the test server certificate
+	options.TLS.CACertificate = ""
+	client, err = NewClient(&server.URL, AuthStruct{Username: api_key, Password: api_secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ = http.NewRequest("GET", server.URL, nil)
+	if _, err = client.Do(req); err == nil {
+		t.Fatal("expected certificate verification error, got nil")
+	}
+}
+
+func TestClientMutualTLS(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	certPEM, keyPEM := generateClientCertificate(t)
+
+	options := DefaultClientOptions()
+	options.Retry.MaxRetries = 0
+	options.TLS = TLSOptions{
+		CACertificate:     string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+		ClientCertificate: certPEM,
+		ClientKey:         keyPEM,
+	}
+
+	client, err := NewClient(&server.URL, AuthStruct{Username: api_key, Password: api_secret}, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: got %d, want %d", res.StatusCode, http.StatusOK)
+	}
+}
+
+func TestClientInvalidTLSOptions(t *testing.T) {
+	endpoint := "https://localhost:8081"
+
+	options := DefaultClientOptions()
+	options.TLS.ClientCertificate = "cert.pem"
+
+	if _, err := NewClient(&endpoint, AuthStruct{Username: api_key, Password: api_secret}, options); err == nil {
+		t.Fatal("expected error on client certificate without key, got nil")
+	}
+
+	options = DefaultClientOptions()
+	options.TLS.CACertificate = "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----"
+
+	if _, err := NewClient(&endpoint, AuthStruct{Username: api_key, Password: api_secret}, options); err == nil {
+		t.Fatal("expected error on invalid CA certificate, got nil")
+	}
+}
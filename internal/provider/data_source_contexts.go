@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &contextsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &contextsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &contextsDataSource{}
+)
+
+// NewContextsDataSource is a helper function to simplify the provider implementation.
+func NewContextsDataSource() datasource.DataSource {
+	return &contextsDataSource{}
+}
+
+// contextsDataSource is the data source implementation.
+type contextsDataSource struct {
+	clients *providerClients
+}
+
+// Metadata returns the data source type name.
+func (d *contextsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contexts"
+}
+
+// Schema defines the schema for the data source.
+func (d *contextsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists schema contexts of the Schema Registry.",
+		Attributes: map[string]schema.Attribute{
+			"registry": dataSourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"contexts": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Names of the schema contexts, including the default context `.`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": dataSourceTLSBlock(),
+		},
+	}
+}
+
+func (d *contextsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config contextsDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateDataSourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contextsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config contextsDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(d.clients, &creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	contexts, err := ListContexts(ctx, schemaAPIClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Contexts",
+			"Could not list contexts: "+err.Error(),
+		)
+		return
+	}
+
+	config.Contexts, diags = types.ListValueFrom(ctx, types.StringType, append([]string{}, contexts...))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *contextsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clients = clients
+}
+
+type contextsDataSourceModel struct {
+	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
+	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
+	Contexts     types.List        `tfsdk:"contexts"`
+}
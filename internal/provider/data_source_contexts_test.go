@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func contextSchemaConfig(subject string) string {
+	schema, err := readSchemaFixture(1)
+	if err != nil {
+		panic(err)
+	}
+
+	return schemaProviderConfig + `
+resource "foxcon_schema" "test" {
+  context = ".foxcon-test"
+  subject_name = "` + subject + `"
+  schema_type = "JSON"
+  hard_delete = true
+  schema = <<-EOT
+` + schema + `
+EOT
+}
+
+data "foxcon_contexts" "test" {
+  depends_on = [foxcon_schema.test]
+}
+`
+}
+
+func TestContextsDataSource(t *testing.T) {
+
+	subject := "team/orders value"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: contextSchemaConfig(subject),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_schema.test", "context", ".foxcon-test"),
+					resource.TestCheckResourceAttr("foxcon_schema.test", "version", "1"),
+					resource.TestCheckTypeSetElemAttr("data.foxcon_contexts.test", "contexts.*", "."),
+					resource.TestCheckTypeSetElemAttr("data.foxcon_contexts.test", "contexts.*", ".foxcon-test"),
+				),
+			},
+			// Import ID is qualified with the context
+			{
+				ResourceName:                         "foxcon_schema.test",
+				ImportState:                          true,
+				ImportStateId:                        ":.foxcon-test:" + subject,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subject_name",
+				ImportStateVerifyIgnore:              []string{"schema", "hard_delete"},
+			},
+		},
+	})
+}
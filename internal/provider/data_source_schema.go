@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &schemaDataSource{}
+	_ datasource.DataSourceWithConfigure      = &schemaDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &schemaDataSource{}
+)
+
+// NewSchemaDataSource is a helper function to simplify the provider implementation.
+func NewSchemaDataSource() datasource.DataSource {
+	return &schemaDataSource{}
+}
+
+// schemaDataSource is the data source implementation.
+type schemaDataSource struct {
+	clients *providerClients
+}
+
+// Metadata returns the data source type name.
+func (d *schemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema"
+}
+
+// Schema defines the schema for the data source.
+func (d *schemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a schema by its id or by a subject version.",
+		Attributes: map[string]schema.Attribute{
+			"registry": dataSourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Schema id. Conflicts with `subject_name`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ExactlyOneOf(
+						path.MatchRoot("subject_name"),
+					),
+				},
+			},
+			"context": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schema context the `id` is looked up in, for example `.staging`. Defaults to the default context `.`. Conflicts with `subject_name`, which is qualified with its context instead.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\.[^:]*$`), "must start with a dot and must not contain a colon"),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("subject_name"),
+					),
+				},
+			},
+			"subject_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the subject, optionally qualified with a context, e.g. `:.staging:orders-value`. Conflicts with `id`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version of the subject, a version number or `latest`. Defaults to `latest`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(latest|[1-9][0-9]*)$`), "must be a version number or latest"),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("subject_name"),
+					),
+				},
+			},
+			"include_deleted": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Resolve soft-deleted subject versions. Defaults to `false`.",
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(
+						path.MatchRoot("subject_name"),
+					),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Computed:    true,
+				Description: "The schema definition.",
+			},
+			"schema_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The schema type: `AVRO`, `JSON` or `PROTOBUF`.",
+			},
+			"references": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Schemas referenced by the schema.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the reference as used in the schema.",
+						},
+						"subject": schema.StringAttribute{
+							Computed:    true,
+							Description: "Subject of the referenced schema.",
+						},
+						"version": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Version of the referenced schema.",
+						},
+					},
+				},
+			},
+			"metadata": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Metadata of the schema.",
+				Attributes: map[string]schema.Attribute{
+					"properties": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "Metadata properties.",
+					},
+					"tags": schema.MapAttribute{
+						ElementType: types.ListType{ElemType: types.StringType},
+						Computed:    true,
+						Description: "Tags assigned to schema paths.",
+					},
+					"sensitive": schema.SetAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "Names of the sensitive properties.",
+					},
+				},
+			},
+			"rule_set": schema.StringAttribute{
+				Computed:    true,
+				Description: "Rule set of the schema as a JSON document.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 of the canonical schema, which does not change with formatting.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": dataSourceTLSBlock(),
+		},
+	}
+}
+
+func (d *schemaDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config schemaDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateDataSourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *schemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config schemaDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(d.clients, &creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var schemaResponse *SchemaResponse
+	var source string
+
+	if config.SubjectName.IsNull() {
+		source = fmt.Sprintf("schema with id %d", config.ID.ValueInt64())
+		schemaResponse, err = GetSchemaByID(ctx, schemaAPIClient, config.ID.ValueInt64(), qualifiedSubject(config.Context, types.StringValue("")))
+	} else {
+		version := config.Version.ValueString()
+		if config.Version.IsNull() {
+			version = "latest"
+		}
+		source = fmt.Sprintf("version %s of subject %s", version, config.SubjectName.ValueString())
+		schemaResponse, err = GetSchemaVersion(ctx, schemaAPIClient, config.SubjectName.ValueString(), version, config.IncludeDeleted.ValueBool())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading schema",
+			"Could not read "+source+": "+err.Error(),
+		)
+		return
+	}
+
+	if schemaResponse == nil {
+		resp.Diagnostics.AddError(
+			"Schema not found",
+			"Could not find "+source+".",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(config.refresh(ctx, schemaResponse)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *schemaDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clients = clients
+}
+
+type schemaDataSourceModel struct {
+	RestEndpoint   types.String           `tfsdk:"rest_endpoint"`
+	Registry       types.String           `tfsdk:"registry"`
+	ID             types.Int64            `tfsdk:"id"`
+	Context        types.String           `tfsdk:"context"`
+	SubjectName    types.String           `tfsdk:"subject_name"`
+	Version        types.String           `tfsdk:"version"`
+	IncludeDeleted types.Bool             `tfsdk:"include_deleted"`
+	Schema         types.String           `tfsdk:"schema"`
+	SchemaType     types.String           `tfsdk:"schema_type"`
+	References     []schemaReferenceModel `tfsdk:"references"`
+	Metadata       *schemaMetadataModel   `tfsdk:"metadata"`
+	RuleSet        types.String           `tfsdk:"rule_set"`
+	Fingerprint    types.String           `tfsdk:"fingerprint"`
+	Credentials    *credentialsModel      `tfsdk:"credentials"`
+	TLS            *tlsModel              `tfsdk:"tls"`
+}
+
+// refresh copies the schema into the computed attributes.
+func (m *schemaDataSourceModel) refresh(ctx context.Context, schema *SchemaResponse) diag.Diagnostics {
+	m.ID = types.Int64Value(int64(schema.ID))
+	m.Schema = types.StringValue(schema.Schema)
+	m.SchemaType = types.StringValue(schema.Type())
+	m.Fingerprint = types.StringValue(schema.Fingerprint())
+
+	m.References = []schemaReferenceModel{}
+	for _, reference := range schema.References {
+		m.References = append(m.References, schemaReferenceModel{
+			Name:    types.StringValue(reference.Name),
+			Subject: types.StringValue(reference.Subject),
+			Version: types.Int64Value(int64(reference.Version)),
+		})
+	}
+
+	m.RuleSet = types.StringNull()
+	if len(schema.RuleSet) > 0 && string(schema.RuleSet) != "null" {
+		m.RuleSet = types.StringValue(string(schema.RuleSet))
+	}
+
+	var diags diag.Diagnostics
+	m.Metadata, diags = newSchemaMetadataModel(ctx, schema.Metadata)
+	return diags
+}
@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &schemaCompatibilityDataSource{}
+	_ datasource.DataSourceWithConfigure      = &schemaCompatibilityDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &schemaCompatibilityDataSource{}
+)
+
+// NewSchemaCompatibilityDataSource is a helper function to simplify the provider implementation.
+func NewSchemaCompatibilityDataSource() datasource.DataSource {
+	return &schemaCompatibilityDataSource{}
+}
+
+// schemaCompatibilityDataSource is the data source implementation.
+type schemaCompatibilityDataSource struct {
+	clients *providerClients
+}
+
+// Metadata returns the data source type name.
+func (d *schemaCompatibilityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_compatibility"
+}
+
+// Schema defines the schema for the data source.
+func (d *schemaCompatibilityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks a candidate schema against a version of the subject with the compatibility level in effect for the subject.",
+		Attributes: map[string]schema.Attribute{
+			"registry": dataSourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"subject_name": schema.StringAttribute{
+				Required:    true,
+				Description: subjectNameDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version of the subject the schema is checked against. Either a version number or `latest`. Defaults to `latest`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(latest|[1-9][0-9]*)$`), "must be a version number or latest"),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "The candidate schema definition. Conflicts with `schema_file`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("schema_file"),
+					),
+				},
+			},
+			"schema_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file with the candidate schema definition. Conflicts with `schema`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"schema_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The candidate schema type. Accepted values are: `AVRO`, `JSON` and `PROTOBUF`. Defaults to `AVRO`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(schemaTypeAvro, schemaTypeJSON, schemaTypeProtobuf),
+				},
+			},
+			"is_compatible": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the candidate schema is compatible with the subject version.",
+			},
+			"messages": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Incompatibilities reported by Schema Registry.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"reference": schema.ListNestedBlock{
+				Description: "Schema referenced by the candidate schema.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the reference as used in the schema. A file name for PROTOBUF, a fully qualified name for AVRO and a URL for JSON.",
+						},
+						"subject": schema.StringAttribute{
+							Required:    true,
+							Description: "Subject of the referenced schema.",
+						},
+						"version": schema.Int64Attribute{
+							Required:    true,
+							Description: "Version of the referenced schema.",
+						},
+					},
+				},
+			},
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": dataSourceTLSBlock(),
+		},
+	}
+}
+
+func (d *schemaCompatibilityDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config schemaCompatibilityDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateDataSourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *schemaCompatibilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config schemaCompatibilityDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := RegisterSchemaRequest{
+		Schema:     config.Schema.ValueString(),
+		SchemaType: config.SchemaType.ValueString(),
+	}
+
+	if !config.SchemaFile.IsNull() {
+		data, err := os.ReadFile(config.SchemaFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schema_file"),
+				"Error reading schema file",
+				"Could not read schema file: "+err.Error(),
+			)
+			return
+		}
+		payload.Schema = string(data)
+	}
+
+	for _, reference := range config.References {
+		payload.References = append(payload.References, SchemaReference{
+			Name:    reference.Name.ValueString(),
+			Subject: reference.Subject.ValueString(),
+			Version: int(reference.Version.ValueInt64()),
+		})
+	}
+
+	version := "latest"
+	if !config.Version.IsNull() {
+		version = config.Version.ValueString()
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(d.clients, &creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result, err := CheckCompatibility(ctx, schemaAPIClient, config.SubjectName.ValueString(), version, payload, true)
+	if errors.Is(err, ErrNotFound) && version == "latest" {
+		// Subject has no versions yet, any schema can be registered
+		result, err = &CompatibilityCheckResponse{IsCompatible: true}, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error checking schema compatibility",
+			"Could not check schema compatibility with subject "+config.SubjectName.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	config.IsCompatible = types.BoolValue(result.IsCompatible)
+	config.Messages, diags = types.ListValueFrom(ctx, types.StringType, append([]string{}, result.Messages...))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *schemaCompatibilityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clients = clients
+}
+
+type schemaCompatibilityDataSourceModel struct {
+	RestEndpoint types.String           `tfsdk:"rest_endpoint"`
+	Registry     types.String           `tfsdk:"registry"`
+	SubjectName  types.String           `tfsdk:"subject_name"`
+	Version      types.String           `tfsdk:"version"`
+	Schema       types.String           `tfsdk:"schema"`
+	SchemaFile   types.String           `tfsdk:"schema_file"`
+	SchemaType   types.String           `tfsdk:"schema_type"`
+	References   []schemaReferenceModel `tfsdk:"reference"`
+	Credentials  *credentialsModel      `tfsdk:"credentials"`
+	TLS          *tlsModel              `tfsdk:"tls"`
+	IsCompatible types.Bool             `tfsdk:"is_compatible"`
+	Messages     types.List             `tfsdk:"messages"`
+}
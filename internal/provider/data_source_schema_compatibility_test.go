@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSchemaCompatibilityDataSource(t *testing.T) {
+
+	subject := "schema-compatibility"
+
+	latest, err := readSchemaFixture(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := exec.Command("git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	candidateFile := strings.TrimSpace(string(output)) + "/tests/schemas/v3.json"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Latest version is compatible with itself
+			{
+				PreConfig: func() {
+					if err := addSubjectVersions(subject, []int{1, 2}); err != nil {
+						panic(err)
+					}
+					// Fixtures add properties to an open content model, which is not backward compatible
+					_, _, err := callSchemaRegistry("PUT", fmt.Sprintf("%s/config/%s", rest_endpoint, subject), bytes.NewBufferString(`{"compatibility": "BACKWARD"}`))
+					if err != nil {
+						panic(err)
+					}
+				},
+				Config: schemaProviderConfig + `
+data "foxcon_schema_compatibility" "test" {
+  subject_name = "` + subject + `"
+  schema_type = "JSON"
+  schema = <<-EOT
+` + latest + `
+EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxcon_schema_compatibility.test", "is_compatible", "true"),
+					resource.TestCheckResourceAttr("data.foxcon_schema_compatibility.test", "messages.#", "0"),
+				),
+			},
+			// Candidate read from a file
+			{
+				Config: schemaProviderConfig + `
+data "foxcon_schema_compatibility" "test" {
+  subject_name = "` + subject + `"
+  version = "2"
+  schema_type = "JSON"
+  schema_file = "` + candidateFile + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxcon_schema_compatibility.test", "is_compatible", "false"),
+					resource.TestCheckResourceAttrSet("data.foxcon_schema_compatibility.test", "messages.0"),
+				),
+			},
+			// Subject without versions accepts any schema
+			{
+				Config: schemaProviderConfig + `
+data "foxcon_schema_compatibility" "test" {
+  subject_name = "schema-compatibility-new"
+  schema_type = "JSON"
+  schema_file = "` + candidateFile + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxcon_schema_compatibility.test", "is_compatible", "true"),
+				),
+			},
+			{
+				Config: schemaProviderConfig + `
+data "foxcon_schema_compatibility" "test" {
+  subject_name = "` + subject + `"
+  schema = "{}"
+  schema_file = "` + candidateFile + `"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
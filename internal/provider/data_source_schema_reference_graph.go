@@ -0,0 +1,328 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &referenceGraphDataSource{}
+	_ datasource.DataSourceWithConfigure      = &referenceGraphDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &referenceGraphDataSource{}
+)
+
+// NewReferenceGraphDataSource is a helper function to simplify the provider implementation.
+func NewReferenceGraphDataSource() datasource.DataSource {
+	return &referenceGraphDataSource{}
+}
+
+// referenceGraphDataSource is the data source implementation.
+type referenceGraphDataSource struct {
+	clients *providerClients
+}
+
+// Metadata returns the data source type name.
+func (d *referenceGraphDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_reference_graph"
+}
+
+// Schema defines the schema for the data source.
+func (d *referenceGraphDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Walks the schema references of a subject version. Returns the schema versions it depends on and the schema versions depending on it as a flat list of nodes and edges.",
+		Attributes: map[string]schema.Attribute{
+			"registry": dataSourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"subject_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the subject, optionally qualified with a context, e.g. `:.staging:orders-value`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version of the subject, a version number or `latest`. Defaults to `latest`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(latest|[1-9][0-9]*)$`), "must be a version number or latest"),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Optional:    true,
+				Description: "Links to follow: `references` walks the schemas the version depends on, `referenced_by` the schemas depending on it and `both` walks both ways. Defaults to `both`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(referenceDirections...),
+				},
+			},
+			"max_depth": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum number of links between the subject version and the collected schema versions. Defaults to `%d`.", defaultReferenceDepth),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Schema versions of the graph, starting with the subject version itself.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subject": schema.StringAttribute{
+							Computed:    true,
+							Description: "Subject of the schema version.",
+						},
+						"version": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Version number.",
+						},
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Schema id.",
+						},
+						"depth": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of links between the subject version and the schema version.",
+						},
+						"direction": schema.StringAttribute{
+							Computed:    true,
+							Description: "How the schema version was reached: `root`, `references` or `referenced_by`.",
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "References between the schema versions of the graph. The `from` schema version references the `to` schema version.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from_subject": schema.StringAttribute{
+							Computed:    true,
+							Description: "Subject of the referencing schema version.",
+						},
+						"from_version": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Version of the referencing schema version.",
+						},
+						"to_subject": schema.StringAttribute{
+							Computed:    true,
+							Description: "Subject of the referenced schema version.",
+						},
+						"to_version": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Version of the referenced schema version.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": dataSourceTLSBlock(),
+		},
+	}
+}
+
+func (d *referenceGraphDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config referenceGraphDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateDataSourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *referenceGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config referenceGraphDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(d.clients, &creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	version := config.Version.ValueString()
+	if config.Version.IsNull() {
+		version = "latest"
+	}
+
+	direction := config.Direction.ValueString()
+	if config.Direction.IsNull() {
+		direction = referenceDirectionBoth
+	}
+
+	maxDepth := int(config.MaxDepth.ValueInt64())
+	if config.MaxDepth.IsNull() {
+		maxDepth = defaultReferenceDepth
+	}
+
+	graph, err := WalkReferenceGraph(ctx, schemaAPIClient, config.SubjectName.ValueString(), version, direction, maxDepth)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading schema references",
+			"Could not read references of version "+version+" of subject "+config.SubjectName.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	config.Nodes = []referenceNodeModel{}
+	for _, node := range graph.Nodes {
+		config.Nodes = append(config.Nodes, referenceNodeModel{
+			Subject:   types.StringValue(node.Subject),
+			Version:   types.Int64Value(int64(node.Version)),
+			ID:        types.Int64Value(int64(node.ID)),
+			Depth:     types.Int64Value(int64(node.Depth)),
+			Direction: types.StringValue(node.Direction),
+		})
+	}
+
+	config.Edges = []referenceEdgeModel{}
+	for _, edge := range graph.Edges {
+		config.Edges = append(config.Edges, referenceEdgeModel{
+			FromSubject: types.StringValue(edge.From.Subject),
+			FromVersion: types.Int64Value(int64(edge.From.Version)),
+			ToSubject:   types.StringValue(edge.To.Subject),
+			ToVersion:   types.Int64Value(int64(edge.To.Version)),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *referenceGraphDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clients = clients
+}
+
+type referenceGraphDataSourceModel struct {
+	RestEndpoint types.String         `tfsdk:"rest_endpoint"`
+	Registry     types.String         `tfsdk:"registry"`
+	SubjectName  types.String         `tfsdk:"subject_name"`
+	Version      types.String         `tfsdk:"version"`
+	Direction    types.String         `tfsdk:"direction"`
+	MaxDepth     types.Int64          `tfsdk:"max_depth"`
+	Nodes        []referenceNodeModel `tfsdk:"nodes"`
+	Edges        []referenceEdgeModel `tfsdk:"edges"`
+	Credentials  *credentialsModel    `tfsdk:"credentials"`
+	TLS          *tlsModel            `tfsdk:"tls"`
+}
+
+type referenceNodeModel struct {
+	Subject   types.String `tfsdk:"subject"`
+	Version   types.Int64  `tfsdk:"version"`
+	ID        types.Int64  `tfsdk:"id"`
+	Depth     types.Int64  `tfsdk:"depth"`
+	Direction types.String `tfsdk:"direction"`
+}
+
+type referenceEdgeModel struct {
+	FromSubject types.String `tfsdk:"from_subject"`
+	FromVersion types.Int64  `tfsdk:"from_version"`
+	ToSubject   types.String `tfsdk:"to_subject"`
+	ToVersion   types.Int64  `tfsdk:"to_version"`
+}
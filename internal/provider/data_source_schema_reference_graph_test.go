@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// referenceGraphResourcesConfig registers an order schema referencing a customer schema referencing an address schema.
+const referenceGraphResourcesConfig = `
+resource "foxcon_schema" "address" {
+  subject_name = "reference-graph-address"
+  hard_delete = true
+  schema = jsonencode({
+    type = "record", name = "Address", namespace = "com.example",
+    fields = [{ name = "street", type = "string" }]
+  })
+}
+
+resource "foxcon_schema" "customer" {
+  subject_name = "reference-graph-customer"
+  hard_delete = true
+  schema = jsonencode({
+    type = "record", name = "Customer", namespace = "com.example",
+    fields = [{ name = "address", type = "com.example.Address" }]
+  })
+
+  reference {
+    name    = "com.example.Address"
+    subject = foxcon_schema.address.subject_name
+    version = foxcon_schema.address.version
+  }
+}
+
+resource "foxcon_schema" "order" {
+  subject_name = "reference-graph-order"
+  hard_delete = true
+  schema = jsonencode({
+    type = "record", name = "Order", namespace = "com.example",
+    fields = [{ name = "customer", type = "com.example.Customer" }]
+  })
+
+  reference {
+    name    = "com.example.Customer"
+    subject = foxcon_schema.customer.subject_name
+    version = foxcon_schema.customer.version
+  }
+}
+`
+
+func TestReferenceGraphDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Both directions from the middle of the chain
+			{
+				Config: schemaProviderConfig + referenceGraphResourcesConfig + `
+data "foxcon_schema_reference_graph" "customer" {
+  subject_name = foxcon_schema.customer.subject_name
+  depends_on = [foxcon_schema.order]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxcon_schema_reference_graph.customer", "nodes.#", "3"),
+					resource.TestCheckResourceAttr("data.foxcon_schema_reference_graph.customer", "nodes.0.subject", "reference-graph-customer"),
+					resource.TestCheckResourceAttr("data.foxcon_schema_reference_graph.customer", "nodes.0.direction", "root"),
+					resource.TestCheckResourceAttr("data.foxcon_schema_reference_graph.customer", "edges.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.foxcon_schema_reference_graph.customer", "nodes.*", map[string]string{
+						"subject":   "reference-graph-address",
+						"direction": "references",
+						"depth":     "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.foxcon_schema_reference_graph.customer", "nodes.*", map[string]string{
+						"subject":   "reference-graph-order",
+						"direction": "referenced_by",
+						"depth":     "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.foxcon_schema_reference_graph.customer", "edges.*", map[string]string{
+						"from_subject": "reference-graph-order",
+						"to_subject":   "reference-graph-customer",
+					}),
+				),
+			},
+			// Depth limit and a single direction
+			{
+				Config: schemaProviderConfig + referenceGraphResourcesConfig + `
+data "foxcon_schema_reference_graph" "address" {
+  subject_name = foxcon_schema.address.subject_name
+  direction = "referenced_by"
+  max_depth = 1
+  depends_on = [foxcon_schema.order]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxcon_schema_reference_graph.address", "nodes.#", "2"),
+					resource.TestCheckResourceAttr("data.foxcon_schema_reference_graph.address", "nodes.1.subject", "reference-graph-customer"),
+					resource.TestCheckResourceAttr("data.foxcon_schema_reference_graph.address", "edges.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -14,8 +14,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &schemaRegistryNormalizationDataSource{}
-	_ datasource.DataSourceWithConfigure = &schemaRegistryNormalizationDataSource{}
+	_ datasource.DataSource                   = &schemaRegistryNormalizationDataSource{}
+	_ datasource.DataSourceWithConfigure      = &schemaRegistryNormalizationDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &schemaRegistryNormalizationDataSource{}
 )
 
 // NewSchemaRegistryNormalizationDataSource is a helper function to simplify the provider implementation.
@@ -25,7 +26,7 @@ func NewSchemaRegistryNormalizationDataSource() datasource.DataSource {
 
 // schemaRegistryNormalizationDataSource is the data source implementation.
 type schemaRegistryNormalizationDataSource struct {
-	client *Client
+	clients *providerClients
 }
 
 // Metadata returns the data source type name.
@@ -38,6 +39,7 @@ func (d *schemaRegistryNormalizationDataSource) Schema(_ context.Context, _ data
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Reads schema registry normalization value.",
 		Attributes: map[string]schema.Attribute{
+			"registry": dataSourceRegistryAttribute(),
 			"rest_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: restEndpointDescription,
@@ -61,11 +63,12 @@ func (d *schemaRegistryNormalizationDataSource) Schema(_ context.Context, _ data
 					},
 				},
 			},
+			"tls": dataSourceTLSBlock(),
 		},
 	}
 }
 
-func (d *schemaRegistryNormalizationDataSource) ValidateConfig(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *schemaRegistryNormalizationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
 	var config schemaRegistryNormalizationDataSourceModel
 
 	diags := req.Config.Get(ctx, &config)
@@ -76,7 +79,9 @@ func (d *schemaRegistryNormalizationDataSource) ValidateConfig(ctx context.Conte
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
 		Credentials:  config.Credentials,
+		TLS:          config.TLS,
 	}
 
 	creds.ValidateDataSourceConfig(resp)
@@ -99,10 +104,12 @@ func (d *schemaRegistryNormalizationDataSource) Read(ctx context.Context, req da
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
 		Credentials:  config.Credentials,
+		TLS:          config.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(d.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(d.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -112,7 +119,7 @@ func (d *schemaRegistryNormalizationDataSource) Read(ctx context.Context, req da
 	}
 
 	// Get schema config
-	schemaConfig, err := GetSubjectConfig(schemaAPIClient, "")
+	schemaConfig, err := GetSubjectConfig(ctx, schemaAPIClient, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Schema config",
@@ -150,11 +157,13 @@ func (d *schemaRegistryNormalizationDataSource) Configure(_ context.Context, req
 		return
 	}
 
-	d.client = clients.SchemaRegistryClient
+	d.clients = clients
 }
 
 type schemaRegistryNormalizationDataSourceModel struct {
 	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
 	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
 	Normalize    types.Bool        `tfsdk:"normalization_enabled"`
 }
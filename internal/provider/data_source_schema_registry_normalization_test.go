@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -64,6 +65,49 @@ data "foxcon_schema_registry_normalization" "test" {
 	})
 }
 
+func TestSchemaRegistryNormalizationDataSourceReadNamedRegistry(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					jsonPayload := []byte(`{"normalize": true}`)
+					req, _ := http.NewRequest("PUT", fmt.Sprintf("%s/config", rest_endpoint), bytes.NewBuffer(jsonPayload))
+					req.Header.Set("Content-Type", "application/json")
+					req.SetBasicAuth(api_key, api_secret)
+					resp, err := http.DefaultClient.Do(req)
+					if err != nil {
+						panic("failed to send HTTP request:" + err.Error())
+					}
+					defer resp.Body.Close()
+
+					if resp.StatusCode != http.StatusOK {
+						panic(fmt.Sprintf("unexpected status code: got %d, want %d", resp.StatusCode, http.StatusOK))
+					}
+				},
+				Config: namedRegistriesProviderConfig + `
+data "foxcon_schema_registry_normalization" "test" {
+  registry = "local"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxcon_schema_registry_normalization.test", "normalization_enabled", "true"),
+					resource.TestCheckResourceAttr("data.foxcon_schema_registry_normalization.test", "registry", "local"),
+				),
+			},
+			{
+				Config: namedRegistriesProviderConfig + `
+data "foxcon_schema_registry_normalization" "test" {
+  registry = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`schema registry 'missing' is not declared`),
+			},
+		},
+	})
+}
+
 func TestSchemaRegistryNormalizationDataSourceReadFalseValue(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
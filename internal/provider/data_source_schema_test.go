@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestSchemaDataSource(t *testing.T) {
+
+	subject := "schema-data-source"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Latest version and lookup by id resolve the same schema
+			{
+				PreConfig: func() {
+					if err := addSubjectVersions(subject, []int{1, 2}); err != nil {
+						panic(err)
+					}
+				},
+				Config: schemaProviderConfig + `
+data "foxcon_schema" "latest" {
+  subject_name = "` + subject + `"
+}
+
+data "foxcon_schema" "first" {
+  subject_name = "` + subject + `"
+  version = "1"
+}
+
+data "foxcon_schema" "by_id" {
+  id = data.foxcon_schema.latest.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxcon_schema.latest", "schema_type", "JSON"),
+					resource.TestCheckResourceAttr("data.foxcon_schema.latest", "references.#", "0"),
+					resource.TestCheckResourceAttrSet("data.foxcon_schema.latest", "fingerprint"),
+					resource.TestCheckResourceAttrPair("data.foxcon_schema.by_id", "schema", "data.foxcon_schema.latest", "schema"),
+					resource.TestCheckResourceAttrPair("data.foxcon_schema.by_id", "fingerprint", "data.foxcon_schema.latest", "fingerprint"),
+					func(s *terraform.State) error {
+						latest := s.RootModule().Resources["data.foxcon_schema.latest"].Primary.Attributes["fingerprint"]
+						first := s.RootModule().Resources["data.foxcon_schema.first"].Primary.Attributes["fingerprint"]
+						if latest == first {
+							return fmt.Errorf("expected versions to have different fingerprints")
+						}
+						return nil
+					},
+				),
+			},
+			// Soft-deleted version is only resolved with include_deleted
+			{
+				PreConfig: func() {
+					if err := removeSubjectVersions(subject, []int{1}); err != nil {
+						panic(err)
+					}
+				},
+				Config: schemaProviderConfig + `
+data "foxcon_schema" "deleted" {
+  subject_name = "` + subject + `"
+  version = "1"
+  include_deleted = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.foxcon_schema.deleted", "id"),
+				),
+			},
+			{
+				Config: schemaProviderConfig + `
+data "foxcon_schema" "deleted" {
+  subject_name = "` + subject + `"
+  version = "1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Could\s+not\s+find\s+version\s+1`),
+			},
+		},
+	})
+}
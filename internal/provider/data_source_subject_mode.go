@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &subjectModeDataSource{}
+	_ datasource.DataSourceWithConfigure      = &subjectModeDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &subjectModeDataSource{}
+)
+
+// NewSubjectModeDataSource is a helper function to simplify the provider implementation.
+func NewSubjectModeDataSource() datasource.DataSource {
+	return &subjectModeDataSource{}
+}
+
+// subjectModeDataSource is the data source implementation.
+type subjectModeDataSource struct {
+	clients *providerClients
+}
+
+// Metadata returns the data source type name.
+func (d *subjectModeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subject_mode"
+}
+
+// Schema defines the schema for the data source.
+func (d *subjectModeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the effective subject mode. Subjects without their own mode report the global mode.",
+		Attributes: map[string]schema.Attribute{
+			"registry": dataSourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"subject_name": schema.StringAttribute{
+				Required:    true,
+				Description: subjectNameDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "Effective mode of the subject.",
+			},
+			"inherited": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the subject has no mode of its own and inherits the mode of its context or the global mode.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": dataSourceTLSBlock(),
+		},
+	}
+}
+
+func (d *subjectModeDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config subjectModeDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateDataSourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *subjectModeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config subjectModeDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(d.clients, &creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mode, inherited, err := EffectiveSubjectMode(ctx, schemaAPIClient, config.SubjectName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Subject mode",
+			"Could not read Subject mode "+config.SubjectName.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	config.Mode = types.StringValue(mode)
+	config.Inherited = types.BoolValue(inherited)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *subjectModeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clients = clients
+}
+
+type subjectModeDataSourceModel struct {
+	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
+	SubjectName  types.String      `tfsdk:"subject_name"`
+	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
+	Mode         types.String      `tfsdk:"mode"`
+	Inherited    types.Bool        `tfsdk:"inherited"`
+}
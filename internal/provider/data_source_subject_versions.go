@@ -18,8 +18,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &subjectVersionsDataSource{}
-	_ datasource.DataSourceWithConfigure = &subjectVersionsDataSource{}
+	_ datasource.DataSource                   = &subjectVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &subjectVersionsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &subjectVersionsDataSource{}
 )
 
 // NewSubjectVersionsDataSource is a helper function to simplify the provider implementation.
@@ -29,7 +30,7 @@ func NewSubjectVersionsDataSource() datasource.DataSource {
 
 // subjectVersionsDataSource is the data source implementation.
 type subjectVersionsDataSource struct {
-	client *Client
+	clients *providerClients
 }
 
 // Metadata returns the data source type name.
@@ -42,6 +43,7 @@ func (d *subjectVersionsDataSource) Schema(_ context.Context, _ datasource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Reads subject schema versions.",
 		Attributes: map[string]schema.Attribute{
+			"registry": dataSourceRegistryAttribute(),
 			"rest_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: restEndpointDescription,
@@ -114,11 +116,12 @@ func (d *subjectVersionsDataSource) Schema(_ context.Context, _ datasource.Schem
 					},
 				},
 			},
+			"tls": dataSourceTLSBlock(),
 		},
 	}
 }
 
-func (d *subjectVersionsDataSource) ValidateConfig(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *subjectVersionsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
 	var config subjectVersionsDataSourceModel
 
 	diags := req.Config.Get(ctx, &config)
@@ -129,7 +132,9 @@ func (d *subjectVersionsDataSource) ValidateConfig(ctx context.Context, req data
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
 		Credentials:  config.Credentials,
+		TLS:          config.TLS,
 	}
 
 	creds.ValidateDataSourceConfig(resp)
@@ -152,11 +157,13 @@ func (d *subjectVersionsDataSource) Read(ctx context.Context, req datasource.Rea
 
 	var subject_config = subjectCleanupResourceModel{
 		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
 		Credentials:  config.Credentials,
+		TLS:          config.TLS,
 		SubjectName:  config.SubjectName,
 	}
 
-	subjectVersions, err := ReadSubjectVersions(ctx, d.client, subject_config)
+	subjectVersions, err := ReadSubjectVersions(ctx, d.clients, subject_config)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -212,13 +219,15 @@ func (d *subjectVersionsDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
-	d.client = clients.SchemaRegistryClient
+	d.clients = clients
 }
 
 type subjectVersionsDataSourceModel struct {
 	RestEndpoint        types.String      `tfsdk:"rest_endpoint"`
+	Registry            types.String      `tfsdk:"registry"`
 	SubjectName         types.String      `tfsdk:"subject_name"`
 	Credentials         *credentialsModel `tfsdk:"credentials"`
+	TLS                 *tlsModel         `tfsdk:"tls"`
 	LatestSchemaVersion types.Int32       `tfsdk:"latest"`
 	AllVersions         types.List        `tfsdk:"all"`
 	ActiveVersions      types.List        `tfsdk:"active"`
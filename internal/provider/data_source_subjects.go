@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &subjectsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &subjectsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &subjectsDataSource{}
+)
+
+// NewSubjectsDataSource is a helper function to simplify the provider implementation.
+func NewSubjectsDataSource() datasource.DataSource {
+	return &subjectsDataSource{}
+}
+
+// subjectsDataSource is the data source implementation.
+type subjectsDataSource struct {
+	clients *providerClients
+}
+
+// Metadata returns the data source type name.
+func (d *subjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subjects"
+}
+
+// Schema defines the schema for the data source.
+func (d *subjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists subjects of the Schema Registry, optionally filtered by prefix, regular expression and soft-deleted state.",
+		Attributes: map[string]schema.Attribute{
+			"registry": dataSourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"subject_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list subjects starting with the prefix.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"subject_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list subjects matching the regular expression.",
+				Validators: []validator.String{
+					RegexValidator{},
+				},
+			},
+			"deleted": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Include soft-deleted subjects. Defaults to `false`.",
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(
+						path.MatchRoot("soft_deleted_only"),
+					),
+				},
+			},
+			"soft_deleted_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list soft-deleted subjects. Defaults to `false`.",
+			},
+			"subjects": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Sorted names of the subjects.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": dataSourceTLSBlock(),
+		},
+	}
+}
+
+func (d *subjectsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config subjectsDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateDataSourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *subjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config subjectsDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(d.clients, &creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var filter *regexp.Regexp
+	if !config.SubjectRegex.IsNull() {
+		// Regex known only on apply is not checked by the validator
+		filter, err = regexp.Compile(config.SubjectRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subject_regex"),
+				"Invalid regular expression",
+				"The value must be a valid regular expression: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	subjects, err := FilterSubjects(ctx, schemaAPIClient, config.SubjectPrefix.ValueString(), config.Deleted.ValueBool(), config.SoftDeletedOnly.ValueBool(), filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Subjects",
+			"Could not list subjects: "+err.Error(),
+		)
+		return
+	}
+
+	config.Subjects, diags = types.SetValueFrom(ctx, types.StringType, subjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *subjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.clients = clients
+}
+
+type subjectsDataSourceModel struct {
+	RestEndpoint    types.String      `tfsdk:"rest_endpoint"`
+	Registry        types.String      `tfsdk:"registry"`
+	SubjectPrefix   types.String      `tfsdk:"subject_prefix"`
+	SubjectRegex    types.String      `tfsdk:"subject_regex"`
+	Deleted         types.Bool        `tfsdk:"deleted"`
+	SoftDeletedOnly types.Bool        `tfsdk:"soft_deleted_only"`
+	Credentials     *credentialsModel `tfsdk:"credentials"`
+	TLS             *tlsModel         `tfsdk:"tls"`
+	Subjects        types.Set         `tfsdk:"subjects"`
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSubjectsDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := addSubjectVersions("subjects-list-a", []int{1}); err != nil {
+						panic(err)
+					}
+					if err := addSubjectVersions("subjects-list-b", []int{1}); err != nil {
+						panic(err)
+					}
+					if err := removeSubjectVersions("subjects-list-b", []int{1}); err != nil {
+						panic(err)
+					}
+				},
+				Config: schemaProviderConfig + `
+data "foxcon_subjects" "active" {
+  subject_prefix = "subjects-list-"
+}
+
+data "foxcon_subjects" "deleted" {
+  subject_prefix = "subjects-list-"
+  deleted = true
+}
+
+data "foxcon_subjects" "soft_deleted" {
+  subject_prefix = "subjects-list-"
+  soft_deleted_only = true
+}
+
+data "foxcon_subjects" "regex" {
+  subject_regex = "^subjects-list-[a]$"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxcon_subjects.active", "subjects.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.foxcon_subjects.active", "subjects.*", "subjects-list-a"),
+					resource.TestCheckResourceAttr("data.foxcon_subjects.deleted", "subjects.#", "2"),
+					resource.TestCheckResourceAttr("data.foxcon_subjects.soft_deleted", "subjects.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.foxcon_subjects.soft_deleted", "subjects.*", "subjects-list-b"),
+					resource.TestCheckResourceAttr("data.foxcon_subjects.regex", "subjects.#", "1"),
+				),
+			},
+			{
+				Config: schemaProviderConfig + `
+data "foxcon_subjects" "test" {
+  subject_regex = "subjects-list-("
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid regular expression`),
+			},
+		},
+	})
+}
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+func SetSubjectCompatibility(ctx context.Context, client *Client, subject_name string, level string) (*CompatibilityResponse, error) {
+	rb, err := json.Marshal(CompatibilityRequest{Compatibility: level})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/config/%s", client.HostURL, url.PathEscape(subject_name)), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", schemaRegistryContentType)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to update subject '%s' compatibility level", subject_name))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CompatibilityResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CheckCompatibility tests the schema against a version of the subject, where version is either a number or "latest".
+// Schema Registry evaluates the check with the compatibility level in effect for the subject.
+func CheckCompatibility(ctx context.Context, client *Client, subject_name string, version string, payload RegisterSchemaRequest, verbose bool) (*CompatibilityCheckResponse, error) {
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/compatibility/subjects/%s/versions/%s?verbose=%t", client.HostURL, url.PathEscape(subject_name), url.PathEscape(version), verbose), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", schemaRegistryContentType)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to check schema compatibility with version '%s' of subject '%s'", version, subject_name))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CompatibilityCheckResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// EffectiveCompatibilityLevel returns the compatibility level of the subject, falling back to the level of
+// its context and then to the global one.
+func EffectiveCompatibilityLevel(ctx context.Context, client *Client, subject_name string) (string, error) {
+	subjectConfig, err := GetSubjectConfig(ctx, client, subject_name)
+	if err != nil {
+		return "", err
+	}
+	if subjectConfig != nil && subjectConfig.CompatibilityLevel != nil {
+		return *subjectConfig.CompatibilityLevel, nil
+	}
+
+	// Context config is kept under the context qualified empty subject, e.g. ":.staging:"
+	if schemaContext, _ := parseQualifiedSubject(subject_name); schemaContext != "" && schemaContext != defaultContext {
+		contextConfig, err := GetSubjectConfig(ctx, client, ":"+schemaContext+":")
+		if err != nil {
+			return "", err
+		}
+		if contextConfig != nil && contextConfig.CompatibilityLevel != nil {
+			return *contextConfig.CompatibilityLevel, nil
+		}
+	}
+
+	schemaRegistryConfig, err := GetSubjectConfig(ctx, client, "")
+	if err != nil {
+		return "", err
+	}
+	if schemaRegistryConfig != nil && schemaRegistryConfig.CompatibilityLevel != nil {
+		return *schemaRegistryConfig.CompatibilityLevel, nil
+	}
+
+	return defaultCompatibilityLevel, nil
+}
+
+// CompatibilityHistoryViolations checks every active version of the subject against the earlier versions
+// as the compatibility level requires and returns the violations found. Checks are evaluated by Schema
+// Registry with the effective level of the subject, which must be able to evaluate the level.
+func CompatibilityHistoryViolations(ctx context.Context, client *Client, subject_name string, level string, effective string) ([]string, error) {
+	required := newCompatibilityRule(level)
+	current := newCompatibilityRule(effective)
+
+	if !current.evaluates(required) {
+		return nil, fmt.Errorf("compatibility level '%s' can not be verified while subject '%s' uses '%s'", level, subject_name, effective)
+	}
+
+	versions, err := ListSubjectVersions(ctx, client, subject_name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make(map[int]RegisterSchemaRequest, len(versions))
+	for _, version := range versions {
+		schema, err := GetSchemaVersion(ctx, client, subject_name, strconv.Itoa(version), false)
+		if err != nil {
+			return nil, err
+		}
+		if schema == nil {
+			continue
+		}
+		schemas[version] = RegisterSchemaRequest{
+			Schema:     schema.Schema,
+			SchemaType: schema.SchemaType,
+			References: schema.References,
+		}
+	}
+
+	var violations []string
+
+	// check tests whether the candidate version is compatible with the registered one
+	check := func(candidate int, registered int, direction string, older int, newer int) error {
+		result, err := CheckCompatibility(ctx, client, subject_name, strconv.Itoa(registered), schemas[candidate], true)
+		if err != nil {
+			return err
+		}
+		if !result.IsCompatible {
+			violation := fmt.Sprintf("version %d is not %s compatible with version %d", newer, direction, older)
+			if len(result.Messages) > 0 {
+				violation += ": " + strings.Join(result.Messages, "; ")
+			}
+			violations = append(violations, violation)
+		}
+		return nil
+	}
+
+	// reads tests whether the reader version can read data written with the writer version
+	reads := func(reader int, writer int, direction string, older int, newer int) error {
+		if current.backward {
+			return check(reader, writer, direction, older, newer)
+		}
+		return check(writer, reader, direction, older, newer)
+	}
+
+	for i := 1; i < len(versions); i++ {
+		newer := versions[i]
+		if _, ok := schemas[newer]; !ok {
+			continue
+		}
+
+		earlier := versions[i-1 : i]
+		if required.transitive {
+			earlier = versions[:i]
+		}
+
+		for _, older := range earlier {
+			if _, ok := schemas[older]; !ok {
+				continue
+			}
+
+			if current.backward && current.forward {
+				err = check(newer, older, "fully", older, newer)
+			} else {
+				if required.backward {
+					err = reads(newer, older, "backward", older, newer)
+				}
+				if err == nil && required.forward {
+					err = reads(older, newer, "forward", older, newer)
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return violations, nil
+}
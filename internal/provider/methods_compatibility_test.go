@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCompatibilityRule(t *testing.T) {
+	cases := []struct {
+		level, current      string
+		tightens, evaluates bool
+	}{
+		{"BACKWARD", "NONE", true, false},
+		{"BACKWARD", "FORWARD", true, true},
+		{"BACKWARD_TRANSITIVE", "BACKWARD", true, true},
+		{"BACKWARD", "BACKWARD_TRANSITIVE", false, true},
+		{"FULL_TRANSITIVE", "FULL", true, true},
+		{"BACKWARD_TRANSITIVE", "FULL", true, false},
+		{"NONE", "FULL_TRANSITIVE", false, false},
+	}
+
+	for _, c := range cases {
+		required, current := newCompatibilityRule(c.level), newCompatibilityRule(c.current)
+		if required.tightens(current) != c.tightens {
+			t.Errorf("%s over %s: unexpected tightens, want %t", c.level, c.current, c.tightens)
+		}
+		if current.evaluates(required) != c.evaluates {
+			t.Errorf("%s over %s: unexpected evaluates, want %t", c.level, c.current, c.evaluates)
+		}
+	}
+}
+
+// newCompatibilityServer serves subject versions with schema "v<n>" and records compatibility
+// checks as "<candidate schema> against <version>". Checks listed in incompatible fail.
+func newCompatibilityServer(t *testing.T, versions []int, incompatible map[string]bool) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var checks []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/subjects/test/versions":
+			_ = json.NewEncoder(w).Encode(versions)
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/subjects/test/versions/"):
+			version := strings.TrimPrefix(r.URL.Path, "/subjects/test/versions/")
+			_, _ = fmt.Fprintf(w, `{"subject":"test","version":%s,"id":%s,"schemaType":"JSON","schema":"v%s"}`, version, version, version)
+		case r.Method == "POST" && strings.HasPrefix(r.URL.Path, "/compatibility/subjects/test/versions/"):
+			var payload RegisterSchemaRequest
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			check := payload.Schema + " against " + strings.TrimPrefix(r.URL.Path, "/compatibility/subjects/test/versions/")
+
+			mu.Lock()
+			checks = append(checks, check)
+			mu.Unlock()
+
+			if incompatible[check] {
+				_, _ = fmt.Fprint(w, `{"is_compatible":false,"messages":["property removed"]}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"is_compatible":true}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return checks
+	}
+}
+
+func TestCompatibilityHistoryViolationsSwapsVersions(t *testing.T) {
+	server, checks := newCompatibilityServer(t, []int{1, 2, 3}, map[string]bool{"v1 against 3": true})
+	client := newTestClient(t, server.URL)
+
+	// FORWARD checks test that the registered version reads the candidate, so a backward
+	// check of a newer version against an older one posts the older schema.
+	violations, err := CompatibilityHistoryViolations(t.Context(), client, "test", "BACKWARD_TRANSITIVE", "FORWARD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedChecks := []string{"v1 against 2", "v1 against 3", "v2 against 3"}
+	if !reflect.DeepEqual(checks(), expectedChecks) {
+		t.Fatalf("unexpected checks: got %v, want %v", checks(), expectedChecks)
+	}
+
+	expectedViolations := []string{"version 3 is not backward compatible with version 1: property removed"}
+	if !reflect.DeepEqual(violations, expectedViolations) {
+		t.Fatalf("unexpected violations: got %v, want %v", violations, expectedViolations)
+	}
+}
+
+func TestCompatibilityHistoryViolationsFullLevel(t *testing.T) {
+	server, checks := newCompatibilityServer(t, []int{1, 2, 3}, nil)
+	client := newTestClient(t, server.URL)
+
+	violations, err := CompatibilityHistoryViolations(t.Context(), client, "test", "FULL_TRANSITIVE", "FULL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("unexpected violations: %v", violations)
+	}
+
+	expectedChecks := []string{"v2 against 1", "v3 against 1", "v3 against 2"}
+	if !reflect.DeepEqual(checks(), expectedChecks) {
+		t.Fatalf("unexpected checks: got %v, want %v", checks(), expectedChecks)
+	}
+
+	if _, err = CompatibilityHistoryViolations(t.Context(), client, "test", "BACKWARD", "NONE"); err == nil {
+		t.Fatal("expected error on a level that can not be evaluated, got nil")
+	}
+}
+
+func TestEffectiveCompatibilityLevel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/config/test":
+			_, _ = fmt.Fprint(w, `{"compatibilityLevel":"FORWARD"}`)
+		case "/config/:.staging:":
+			_, _ = fmt.Fprint(w, `{"compatibilityLevel":"FULL"}`)
+		case "/config/":
+			_, _ = fmt.Fprint(w, `{"compatibilityLevel":"BACKWARD"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"error_code":40401,"message":"Subject not found"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := newTestClient(t, server.URL)
+
+	tests := map[string]string{
+		"test":               "FORWARD",
+		":.staging:inherits": "FULL",
+		":.other:inherits":   "BACKWARD",
+		"inherits":           "BACKWARD",
+	}
+
+	for subject, expected := range tests {
+		level, err := EffectiveCompatibilityLevel(t.Context(), client, subject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if level != expected {
+			t.Errorf("expected %s level of %s, got %s", expected, subject, level)
+		}
+	}
+}
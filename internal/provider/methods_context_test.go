@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListContexts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/contexts" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `[".",".staging"]`)
+	}))
+	defer server.Close()
+
+	contexts, err := ListContexts(t.Context(), newTestClient(t, server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(contexts, []string{".", ".staging"}) {
+		t.Fatalf("unexpected contexts: %v", contexts)
+	}
+}
+
+func TestSubjectNamesArePathEscaped(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.RequestURI)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	subject := qualifiedSubject(types.StringValue(".staging"), types.StringValue("team/orders value%"))
+
+	if _, err := ListSubjectVersions(t.Context(), client, subject, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteSchemaVersion(t.Context(), client, subject, 1, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteSubjectMode(t.Context(), client, subject); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"GET /subjects/:.staging:team%2Forders%20value%25/versions?deleted=false",
+		"DELETE /subjects/:.staging:team%2Forders%20value%25/versions/1?permanent=true",
+		"DELETE /mode/:.staging:team%2Forders%20value%25",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("unexpected requests:\ngot  %v\nwant %v", requests, expected)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+func CreateExporter(ctx context.Context, client *Client, payload ExporterRequest) error {
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/exporters", client.HostURL), bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", schemaRegistryContentType)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return newAPIError(res, fmt.Sprintf("failed to create exporter '%s'", payload.Name))
+	}
+
+	return nil
+}
+
+// UpdateExporter replaces the exporter definition. Schema Registry requires the exporter to be paused.
+func UpdateExporter(ctx context.Context, client *Client, name string, payload ExporterRequest) error {
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/exporters/%s", client.HostURL, url.PathEscape(name)), bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", schemaRegistryContentType)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return newAPIError(res, fmt.Sprintf("failed to update exporter '%s'", name))
+	}
+
+	return nil
+}
+
+func GetExporter(ctx context.Context, client *Client, name string) (*ExporterResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/exporters/%s", client.HostURL, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// Exporter does not exist
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to get exporter '%s'", name))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ExporterResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func GetExporterStatus(ctx context.Context, client *Client, name string) (*ExporterStatusResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/exporters/%s/status", client.HostURL, url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to get exporter '%s' status", name))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ExporterStatusResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// PauseExporter stops exporting schemas until the exporter is resumed.
+func PauseExporter(ctx context.Context, client *Client, name string) error {
+	return exporterCommand(ctx, client, name, "pause")
+}
+
+// ResumeExporter continues exporting schemas from the current offset.
+func ResumeExporter(ctx context.Context, client *Client, name string) error {
+	return exporterCommand(ctx, client, name, "resume")
+}
+
+// ResetExporter clears the exporter offset, every schema is exported again once the exporter is resumed.
+func ResetExporter(ctx context.Context, client *Client, name string) error {
+	return exporterCommand(ctx, client, name, "reset")
+}
+
+func exporterCommand(ctx context.Context, client *Client, name string, command string) error {
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/exporters/%s/%s", client.HostURL, url.PathEscape(name), command), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return newAPIError(res, fmt.Sprintf("failed to %s exporter '%s'", command, name))
+	}
+
+	return nil
+}
+
+func DeleteExporter(ctx context.Context, client *Client, name string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/exporters/%s", client.HostURL, url.PathEscape(name)), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// Exporter is already deleted
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
+		return newAPIError(res, fmt.Sprintf("failed to delete exporter '%s'", name))
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type fakeExporter struct {
+	definition ExporterRequest
+	status     ExporterStatusResponse
+}
+
+// newExporterServer serves the exporter API of Schema Registry. Like Schema Registry, it refuses
+// to update or reset a running exporter.
+func newExporterServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	exporters := map[string]*fakeExporter{}
+
+	notFound := func(w http.ResponseWriter, name string) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"error_code":40450,"message":"Exporter '%s' not found"}`, name)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/exporters" && r.Method == "POST" {
+			var payload ExporterRequest
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if _, ok := exporters[payload.Name]; ok {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = fmt.Fprintf(w, `{"error_code":40950,"message":"Exporter '%s' already exists"}`, payload.Name)
+				return
+			}
+			exporters[payload.Name] = &fakeExporter{
+				definition: payload,
+				status:     ExporterStatusResponse{Name: payload.Name, State: "RUNNING", Offset: int64(len(payload.Subjects))},
+			}
+			_, _ = fmt.Fprintf(w, `{"name":"%s"}`, payload.Name)
+			return
+		}
+
+		name, command, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/exporters/"), "/")
+		exporter, ok := exporters[name]
+		if !ok {
+			notFound(w, name)
+			return
+		}
+
+		paused := exporter.status.State == "PAUSED"
+		notPaused := func() {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = fmt.Fprintf(w, `{"error_code":40960,"message":"Exporter '%s' is not paused"}`, name)
+		}
+
+		switch {
+		case command == "" && r.Method == "GET":
+			_ = json.NewEncoder(w).Encode(ExporterResponse(exporter.definition))
+		case command == "" && r.Method == "PUT":
+			if !paused {
+				notPaused()
+				return
+			}
+			var payload ExporterRequest
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			payload.Name = name
+			exporter.definition = payload
+			_, _ = fmt.Fprintf(w, `{"name":"%s"}`, name)
+		case command == "" && r.Method == "DELETE":
+			delete(exporters, name)
+		case command == "status" && r.Method == "GET":
+			_ = json.NewEncoder(w).Encode(exporter.status)
+		case command == "pause" && r.Method == "PUT":
+			exporter.status.State = "PAUSED"
+		case command == "resume" && r.Method == "PUT":
+			exporter.status.State = "RUNNING"
+			exporter.status.Offset += int64(len(exporter.definition.Subjects))
+		case command == "reset" && r.Method == "PUT":
+			if !paused {
+				notPaused()
+				return
+			}
+			exporter.status.Offset = 0
+		default:
+			notFound(w, name)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestExporterLifecycle(t *testing.T) {
+	client := newTestClient(t, newExporterServer(t).URL)
+
+	payload := ExporterRequest{
+		Name:        "dc2",
+		Subjects:    []string{"orders*"},
+		ContextType: "AUTO",
+		Config:      map[string]string{exporterURLConfig: "http://dc2:8081"},
+	}
+
+	if err := CreateExporter(t.Context(), client, payload); err != nil {
+		t.Fatal(err)
+	}
+
+	exporter, err := GetExporter(t.Context(), client, "dc2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exporter == nil || !reflect.DeepEqual(exporter.Subjects, payload.Subjects) || exporter.Config[exporterURLConfig] != "http://dc2:8081" {
+		t.Fatalf("unexpected exporter: %+v", exporter)
+	}
+
+	// Running exporter can not be updated
+	payload.SubjectRenameFormat = "dc1.${subject}"
+	if err = UpdateExporter(t.Context(), client, "dc2", payload); err == nil {
+		t.Fatal("expected error on update of a running exporter, got nil")
+	}
+
+	if err = PauseExporter(t.Context(), client, "dc2"); err != nil {
+		t.Fatal(err)
+	}
+	if err = UpdateExporter(t.Context(), client, "dc2", payload); err != nil {
+		t.Fatal(err)
+	}
+	if err = ResetExporter(t.Context(), client, "dc2"); err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := GetExporterStatus(t.Context(), client, "dc2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.State != "PAUSED" || status.Offset != 0 {
+		t.Fatalf("unexpected exporter status: got %s at offset %d, want PAUSED at offset 0", status.State, status.Offset)
+	}
+
+	if err = ResumeExporter(t.Context(), client, "dc2"); err != nil {
+		t.Fatal(err)
+	}
+
+	status, err = GetExporterStatus(t.Context(), client, "dc2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.State != "RUNNING" || status.Offset != 1 {
+		t.Fatalf("unexpected exporter status: got %s at offset %d, want RUNNING at offset 1", status.State, status.Offset)
+	}
+
+	if err = DeleteExporter(t.Context(), client, "dc2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Deleted exporter is reported as missing
+	if exporter, err = GetExporter(t.Context(), client, "dc2"); err != nil || exporter != nil {
+		t.Fatalf("unexpected exporter after delete: %+v (error %v)", exporter, err)
+	}
+	if err = DeleteExporter(t.Context(), client, "dc2"); err != nil {
+		t.Fatalf("unexpected error on delete of a missing exporter: %s", err)
+	}
+	if err = PauseExporter(t.Context(), client, "dc2"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("unexpected error on pause of a missing exporter: %v", err)
+	}
+}
@@ -4,39 +4,43 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
-func (c *Client) GetUserInvitationById(invitationId string) (*Invitation, error) {
+func (c *Client) GetUserInvitationById(ctx context.Context, invitationId string) (*Invitation, error) {
 
 	invitation := Invitation{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/iam/v2/invitations/%s", c.HostURL, invitationId), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/iam/v2/invitations/%s", c.HostURL, url.PathEscape(invitationId)), nil)
 
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(c.Auth.Username, c.Auth.Password)
-
-	res, err := c.HTTPClient.Do(req)
+	res, err := c.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to get invitation '%s'", invitationId))
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	if res.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
 	err = json.Unmarshal(body, &invitation)
 	if err != nil {
 		return nil, err
@@ -45,25 +49,27 @@ func (c *Client) GetUserInvitationById(invitationId string) (*Invitation, error)
 	return &invitation, nil
 }
 
-func (c *Client) CreateInvitation(payload InvitationItem) (*Invitation, error) {
+func (c *Client) CreateInvitation(ctx context.Context, payload InvitationItem) (*Invitation, error) {
 	rb, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/iam/v2/invitations", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/iam/v2/invitations", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(c.Auth.Username, c.Auth.Password)
-
-	res, err := c.HTTPClient.Do(req)
+	res, err := c.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return nil, newAPIError(res, fmt.Sprintf("failed to create invitation for '%s'", payload.Email))
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
@@ -79,44 +85,29 @@ func (c *Client) CreateInvitation(payload InvitationItem) (*Invitation, error) {
 	return &invitation, nil
 }
 
-func (c *Client) GetUserInvitationByParameter(search_type, search_parameter string) (*Invitation, error) {
-
-	invitationList := InvitationList{}
-	invitation := Invitation{}
-
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/iam/v2/invitations?%s=%s", c.HostURL, search_type, search_parameter), nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	req.SetBasicAuth(c.Auth.Username, c.Auth.Password)
-
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get list of invitations. Response code %d", res.StatusCode)
-	}
+// GetUserInvitationByParameter looks up the invitation of a user by "email" or "user" id.
+// Every page is searched until an exact match is found.
+func (c *Client) GetUserInvitationByParameter(ctx context.Context, search_type, search_parameter string) (*Invitation, error) {
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+	var match func(Invitation) bool
 
-	err = json.Unmarshal(body, &invitationList)
-	if err != nil {
-		return nil, err
+	switch search_type {
+	case "email":
+		match = func(invitation Invitation) bool {
+			return strings.EqualFold(invitation.Email, search_parameter)
+		}
+	case "user":
+		match = func(invitation Invitation) bool {
+			return invitation.User.ID == search_parameter
+		}
+	default:
+		return nil, fmt.Errorf("unsupported invitation search type '%s'", search_type)
 	}
 
-	if len(invitationList.Data) == 0 {
-		return nil, nil
-	}
+	query := url.Values{}
+	query.Set(search_type, search_parameter)
 
-	invitation = invitationList.Data[0]
+	paginator := NewPaginator[Invitation](c, "/iam/v2/invitations", query, 0, "failed to get list of invitations")
 
-	return &invitation, nil
+	return paginator.Find(ctx, match)
 }
@@ -4,34 +4,35 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
-func SetSubjectMode(client *Client, subject_name string, payload SubjectModeRequest) (*SubjectModeResponse, error) {
+// SetSubjectMode sets the subject mode. Force allows switching a subject with versions to IMPORT mode.
+func SetSubjectMode(ctx context.Context, client *Client, subject_name string, payload SubjectModeRequest, force bool) (*SubjectModeResponse, error) {
 	rb, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/mode/%s", client.HostURL, subject_name), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/mode/%s?force=%t", client.HostURL, url.PathEscape(subject_name), force), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(client.Auth.Username, client.Auth.Password)
-
-	res, err := client.HTTPClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to update subject configuration. Response code %d", res.StatusCode)
+		return nil, newAPIError(res, fmt.Sprintf("failed to update subject '%s' mode", subject_name))
 	}
 
 	body, err := io.ReadAll(res.Body)
@@ -49,16 +50,14 @@ func SetSubjectMode(client *Client, subject_name string, payload SubjectModeRequ
 	return &response, nil
 }
 
-func GetSubjectMode(client *Client, subject_name string) (*SubjectModeResponse, error) {
+func GetSubjectMode(ctx context.Context, client *Client, subject_name string) (*SubjectModeResponse, error) {
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/mode/%s", client.HostURL, subject_name), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/mode/%s", client.HostURL, url.PathEscape(subject_name)), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(client.Auth.Username, client.Auth.Password)
-
-	res, err := client.HTTPClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +69,7 @@ func GetSubjectMode(client *Client, subject_name string) (*SubjectModeResponse,
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get subject configuration. Response code %d", res.StatusCode)
+		return nil, newAPIError(res, fmt.Sprintf("failed to get subject '%s' mode", subject_name))
 	}
 
 	body, err := io.ReadAll(res.Body)
@@ -87,3 +86,172 @@ func GetSubjectMode(client *Client, subject_name string) (*SubjectModeResponse,
 
 	return &response, nil
 }
+
+// DeleteSubjectMode deletes the subject mode so the subject inherits the global mode.
+func DeleteSubjectMode(ctx context.Context, client *Client, subject_name string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/mode/%s", client.HostURL, url.PathEscape(subject_name)), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// Subject mode is already inherited
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
+		return newAPIError(res, fmt.Sprintf("failed to delete subject '%s' mode", subject_name))
+	}
+
+	return nil
+}
+
+func GetGlobalMode(ctx context.Context, client *Client) (*SubjectModeResponse, error) {
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/mode", client.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, "failed to get global mode")
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubjectModeResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// EffectiveSubjectMode returns the mode of the subject and whether it is inherited. A subject qualified with
+// a context inherits the mode of its context first, then the global mode.
+func EffectiveSubjectMode(ctx context.Context, client *Client, subject_name string) (string, bool, error) {
+	subjectMode, err := GetSubjectMode(ctx, client, subject_name)
+	if err != nil {
+		return "", false, err
+	}
+	if subjectMode != nil {
+		return subjectMode.Mode, false, nil
+	}
+
+	// Context mode is kept under the context qualified empty subject, e.g. ":.staging:"
+	if schemaContext, _ := parseQualifiedSubject(subject_name); schemaContext != "" && schemaContext != defaultContext {
+		contextMode, err := GetSubjectMode(ctx, client, ":"+schemaContext+":")
+		if err != nil {
+			return "", false, err
+		}
+		if contextMode != nil {
+			return contextMode.Mode, true, nil
+		}
+	}
+
+	globalMode, err := GetGlobalMode(ctx, client)
+	if err != nil {
+		return "", false, err
+	}
+
+	return globalMode.Mode, true, nil
+}
+
+// SetGlobalMode sets the global mode. Force allows switching a registry with versions to IMPORT mode.
+func SetGlobalMode(ctx context.Context, client *Client, payload SubjectModeRequest, force bool) (*SubjectModeResponse, error) {
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/mode?force=%t", client.HostURL, force), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, "failed to update global mode")
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubjectModeResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CheckImportMode refuses switching a subject, or the whole registry when subject name is empty, that still
+// has versions to IMPORT mode unless force is set. Subjects already in IMPORT mode are not checked.
+func CheckImportMode(ctx context.Context, client *Client, subject_name string, mode string, force bool) error {
+	if mode != importMode || force {
+		return nil
+	}
+
+	// Only a switch to IMPORT mode is guarded, versions may have been imported since
+	var current string
+	if subject_name == "" {
+		globalMode, err := GetGlobalMode(ctx, client)
+		if err != nil {
+			return err
+		}
+		current = globalMode.Mode
+	} else {
+		var err error
+		current, _, err = EffectiveSubjectMode(ctx, client, subject_name)
+		if err != nil {
+			return err
+		}
+	}
+	if current == importMode {
+		return nil
+	}
+
+	// Listed subjects have active versions, deleted subjects are left out
+	if subject_name == "" {
+		subjects, err := ListSubjects(ctx, client, "", false)
+		if err != nil {
+			return err
+		}
+		if len(subjects) > 0 {
+			return fmt.Errorf("registry is not empty, it has %d subjects. Set force to switch the registry to %s mode", len(subjects), importMode)
+		}
+		return nil
+	}
+
+	versions, err := ListSubjectVersions(ctx, client, subject_name, false)
+	if err != nil {
+		return err
+	}
+	if len(versions) > 0 {
+		return fmt.Errorf("subject '%s' has %d versions. Set force to switch the subject to %s mode", subject_name, len(versions), importMode)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEffectiveSubjectMode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/mode":
+			_, _ = fmt.Fprint(w, `{"mode":"READWRITE"}`)
+		case "/mode/own":
+			_, _ = fmt.Fprint(w, `{"mode":"READONLY"}`)
+		case "/mode/:.staging:":
+			_, _ = fmt.Fprint(w, `{"mode":"IMPORT"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"error_code":40409,"message":"Subject 'inherited' does not have subject-level mode configured"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	mode, inherited, err := EffectiveSubjectMode(t.Context(), client, "own")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode != "READONLY" || inherited {
+		t.Fatalf("unexpected subject mode: got %s (inherited %t), want READONLY", mode, inherited)
+	}
+
+	mode, inherited, err = EffectiveSubjectMode(t.Context(), client, "inherited")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode != "READWRITE" || !inherited {
+		t.Fatalf("unexpected subject mode: got %s (inherited %t), want inherited READWRITE", mode, inherited)
+	}
+
+	mode, inherited, err = EffectiveSubjectMode(t.Context(), client, ":.staging:inherited")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode != "IMPORT" || !inherited {
+		t.Fatalf("unexpected subject mode: got %s (inherited %t), want inherited context IMPORT", mode, inherited)
+	}
+
+	mode, inherited, err = EffectiveSubjectMode(t.Context(), client, ":.other:inherited")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode != "READWRITE" || !inherited {
+		t.Fatalf("unexpected subject mode: got %s (inherited %t), want inherited READWRITE", mode, inherited)
+	}
+}
+
+// newModeServer serves a registry where only the "orders" subject has versions.
+func newModeServer(t *testing.T, subjects string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/mode":
+			_, _ = fmt.Fprint(w, `{"mode":"READWRITE"}`)
+		case "/mode/imported":
+			_, _ = fmt.Fprint(w, `{"mode":"IMPORT"}`)
+		case "/subjects":
+			_, _ = fmt.Fprint(w, subjects)
+		case "/subjects/imported/versions":
+			_, _ = fmt.Fprint(w, `[1]`)
+		case "/subjects/orders/versions":
+			_, _ = fmt.Fprint(w, `[1,2]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestCheckImportModeSubject(t *testing.T) {
+	client := newTestClient(t, newModeServer(t, `["orders"]`).URL)
+
+	if err := CheckImportMode(t.Context(), client, "orders", "IMPORT", false); err == nil {
+		t.Fatal("expected error on IMPORT mode for a subject with versions, got nil")
+	}
+
+	if err := CheckImportMode(t.Context(), client, "orders", "IMPORT", true); err != nil {
+		t.Fatalf("unexpected error with force: %s", err)
+	}
+
+	if err := CheckImportMode(t.Context(), client, "orders", "READONLY", false); err != nil {
+		t.Fatalf("unexpected error on READONLY mode: %s", err)
+	}
+
+	if err := CheckImportMode(t.Context(), client, "new", "IMPORT", false); err != nil {
+		t.Fatalf("unexpected error on IMPORT mode for a subject without versions: %s", err)
+	}
+
+	if err := CheckImportMode(t.Context(), client, "imported", "IMPORT", false); err != nil {
+		t.Fatalf("unexpected error on a subject already in IMPORT mode: %s", err)
+	}
+}
+
+func TestCheckImportModeRegistry(t *testing.T) {
+	client := newTestClient(t, newModeServer(t, `["orders","payments"]`).URL)
+
+	err := CheckImportMode(t.Context(), client, "", "IMPORT", false)
+	if err == nil || !strings.Contains(err.Error(), "it has 2 subjects") {
+		t.Fatalf("unexpected error on IMPORT mode for a registry with subjects: %v", err)
+	}
+
+	client = newTestClient(t, newModeServer(t, `[]`).URL)
+
+	if err = CheckImportMode(t.Context(), client, "", "IMPORT", false); err != nil {
+		t.Fatalf("unexpected error on IMPORT mode for an empty registry: %s", err)
+	}
+}
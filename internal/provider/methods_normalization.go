@@ -4,34 +4,34 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 )
 
-func SetSubjectConfig(client *Client, subject_name string, payload NormalizeRequest) (*NormalizeResponse, error) {
+func SetSubjectConfig(ctx context.Context, client *Client, subject_name string, payload NormalizeRequest) (*NormalizeResponse, error) {
 	rb, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/config/%s", client.HostURL, subject_name), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/config/%s", client.HostURL, url.PathEscape(subject_name)), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(client.Auth.Username, client.Auth.Password)
-
-	res, err := client.HTTPClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to update subject configuration. Response code %d", res.StatusCode)
+		return nil, newAPIError(res, fmt.Sprintf("failed to update subject '%s' configuration", subject_name))
 	}
 
 	body, err := io.ReadAll(res.Body)
@@ -49,16 +49,14 @@ func SetSubjectConfig(client *Client, subject_name string, payload NormalizeRequ
 	return &response, nil
 }
 
-func GetSubjectConfig(client *Client, subject_name string) (*SchemaConfigResponse, error) {
+func GetSubjectConfig(ctx context.Context, client *Client, subject_name string) (*SchemaConfigResponse, error) {
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/config/%s", client.HostURL, subject_name), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/config/%s", client.HostURL, url.PathEscape(subject_name)), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(client.Auth.Username, client.Auth.Password)
-
-	res, err := client.HTTPClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +68,7 @@ func GetSubjectConfig(client *Client, subject_name string) (*SchemaConfigRespons
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get subject configuration. Response code %d", res.StatusCode)
+		return nil, newAPIError(res, fmt.Sprintf("failed to get subject '%s' configuration", subject_name))
 	}
 
 	body, err := io.ReadAll(res.Body)
@@ -88,22 +86,20 @@ func GetSubjectConfig(client *Client, subject_name string) (*SchemaConfigRespons
 	return &response, nil
 }
 
-func DeleteSubjectConfig(client *Client, subject_name string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/config/%s", client.HostURL, subject_name), nil)
+func DeleteSubjectConfig(ctx context.Context, client *Client, subject_name string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/config/%s", client.HostURL, url.PathEscape(subject_name)), nil)
 	if err != nil {
 		return err
 	}
 
-	req.SetBasicAuth(client.Auth.Username, client.Auth.Password)
-
-	res, err := client.HTTPClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected response code received on deleting schema config '%s' request. Expected %d Received %d", subject_name, http.StatusNoContent, res.StatusCode)
+		return newAPIError(res, fmt.Sprintf("failed to delete subject '%s' configuration", subject_name))
 	}
 
 	return nil
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// GetReferencedBy returns the ids of the schemas referencing a version of the subject.
+func GetReferencedBy(ctx context.Context, client *Client, subject_name string, version int) ([]int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/subjects/%s/versions/%d/referencedby", client.HostURL, url.PathEscape(subject_name), version), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// Subject or version does not exist
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to get schemas referencing version %d of subject '%s'", version, subject_name))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []int
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetSchemaSubjectVersions returns the subject versions a schema is registered under.
+func GetSchemaSubjectVersions(ctx context.Context, client *Client, id int) ([]SubjectVersion, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/schemas/ids/%d/versions", client.HostURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// Schema does not exist
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to get versions of schema with id %d", id))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []SubjectVersion
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// WalkReferenceGraph collects the schema versions around a subject version. References and referenced-by
+// links are followed breadth first up to maxDepth links away from the root. Every version is visited once,
+// so reference cycles end the walk.
+func WalkReferenceGraph(ctx context.Context, client *Client, subject_name string, version string, direction string, maxDepth int) (*ReferenceGraph, error) {
+	root, err := GetSchemaVersion(ctx, client, subject_name, version, false)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, fmt.Errorf("version '%s' of subject '%s' does not exist: %w", version, subject_name, ErrNotFound)
+	}
+
+	graph := &ReferenceGraph{}
+	visited := map[SubjectVersion]bool{}
+	edges := map[ReferenceEdge]bool{}
+
+	type step struct {
+		schema    *SchemaResponse
+		depth     int
+		direction string
+	}
+
+	addEdge := func(edge ReferenceEdge) {
+		if !edges[edge] {
+			edges[edge] = true
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+
+	visit := func(schema *SchemaResponse, depth int, direction string) *step {
+		key := SubjectVersion{Subject: schema.Subject, Version: schema.Version}
+		if visited[key] {
+			return nil
+		}
+		visited[key] = true
+		graph.Nodes = append(graph.Nodes, ReferenceNode{SubjectVersion: key, ID: schema.ID, Depth: depth, Direction: direction})
+		return &step{schema: schema, depth: depth, direction: direction}
+	}
+
+	queue := []*step{visit(root, 0, referenceDirectionRoot)}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current.depth >= maxDepth {
+			continue
+		}
+
+		from := SubjectVersion{Subject: current.schema.Subject, Version: current.schema.Version}
+		down := current.direction != referenceDirectionReferencedBy && direction != referenceDirectionReferencedBy
+		up := current.direction != referenceDirectionReferences && direction != referenceDirectionReferences
+
+		if down {
+			for _, reference := range current.schema.References {
+				referenced, err := GetSchemaVersion(ctx, client, reference.Subject, fmt.Sprint(reference.Version), true)
+				if err != nil {
+					return nil, err
+				}
+				// Referenced version was deleted permanently
+				if referenced == nil {
+					continue
+				}
+
+				addEdge(ReferenceEdge{From: from, To: SubjectVersion{Subject: reference.Subject, Version: reference.Version}})
+				if next := visit(referenced, current.depth+1, referenceDirectionReferences); next != nil {
+					queue = append(queue, next)
+				}
+			}
+		}
+
+		if up {
+			ids, err := GetReferencedBy(ctx, client, from.Subject, from.Version)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, id := range ids {
+				versions, err := GetSchemaSubjectVersions(ctx, client, id)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, referencing := range versions {
+					schema, err := GetSchemaVersion(ctx, client, referencing.Subject, fmt.Sprint(referencing.Version), true)
+					if err != nil {
+						return nil, err
+					}
+					if schema == nil {
+						continue
+					}
+
+					addEdge(ReferenceEdge{From: referencing, To: from})
+					if next := visit(schema, current.depth+1, referenceDirectionReferencedBy); next != nil {
+						queue = append(queue, next)
+					}
+				}
+			}
+		}
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newReferenceServer serves schemas where "orders" references "customer", which references "address".
+// "address" references "customer" back, which Schema Registry does not allow, to test cycle protection.
+func newReferenceServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	schemas := map[string]SchemaResponse{
+		"orders":   {Subject: "orders", Version: 1, ID: 3, References: []SchemaReference{{Name: "customer.json", Subject: "customer", Version: 1}}},
+		"customer": {Subject: "customer", Version: 1, ID: 2, References: []SchemaReference{{Name: "address.json", Subject: "address", Version: 1}}},
+		"address":  {Subject: "address", Version: 1, ID: 1, References: []SchemaReference{{Name: "customer.json", Subject: "customer", Version: 1}}},
+	}
+	referencedBy := map[string][]int{"customer": {3, 1}, "address": {2}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		path := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+		switch {
+		case len(path) == 4 && path[0] == "subjects" && (path[3] == "1" || path[3] == "latest"):
+			schema, ok := schemas[path[1]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(schema)
+		case len(path) == 5 && path[0] == "subjects" && path[4] == "referencedby":
+			_ = json.NewEncoder(w).Encode(append([]int{}, referencedBy[path[1]]...))
+		case len(path) == 4 && path[0] == "schemas" && path[3] == "versions":
+			for _, schema := range schemas {
+				if fmt.Sprint(schema.ID) == path[2] {
+					_ = json.NewEncoder(w).Encode([]SubjectVersion{{Subject: schema.Subject, Version: schema.Version}})
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func nodeNames(graph *ReferenceGraph) []string {
+	var names []string
+	for _, node := range graph.Nodes {
+		names = append(names, fmt.Sprintf("%s:%d:%s", node.Subject, node.Depth, node.Direction))
+	}
+	return names
+}
+
+func TestWalkReferenceGraph(t *testing.T) {
+	client := newTestClient(t, newReferenceServer(t).URL)
+
+	graph, err := WalkReferenceGraph(t.Context(), client, "customer", "latest", referenceDirectionBoth, defaultReferenceDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Address is reached by references first, the cycle back to customer is recorded as an edge only
+	expected := []string{"customer:0:root", "address:1:references", "orders:1:referenced_by"}
+	if !reflect.DeepEqual(nodeNames(graph), expected) {
+		t.Fatalf("unexpected nodes: got %v, want %v", nodeNames(graph), expected)
+	}
+
+	customer := SubjectVersion{Subject: "customer", Version: 1}
+	address := SubjectVersion{Subject: "address", Version: 1}
+	orders := SubjectVersion{Subject: "orders", Version: 1}
+	expectedEdges := []ReferenceEdge{
+		{From: customer, To: address},
+		{From: orders, To: customer},
+		{From: address, To: customer},
+	}
+	if !reflect.DeepEqual(graph.Edges, expectedEdges) {
+		t.Fatalf("unexpected edges: got %v, want %v", graph.Edges, expectedEdges)
+	}
+}
+
+func TestWalkReferenceGraphDirectionAndDepth(t *testing.T) {
+	client := newTestClient(t, newReferenceServer(t).URL)
+
+	graph, err := WalkReferenceGraph(t.Context(), client, "orders", "1", referenceDirectionReferences, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"orders:0:root", "customer:1:references"}
+	if !reflect.DeepEqual(nodeNames(graph), expected) {
+		t.Fatalf("unexpected nodes: got %v, want %v", nodeNames(graph), expected)
+	}
+
+	graph, err = WalkReferenceGraph(t.Context(), client, "address", "1", referenceDirectionReferencedBy, defaultReferenceDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = []string{"address:0:root", "customer:1:referenced_by", "orders:2:referenced_by"}
+	if !reflect.DeepEqual(nodeNames(graph), expected) {
+		t.Fatalf("unexpected nodes: got %v, want %v", nodeNames(graph), expected)
+	}
+
+	if _, err = WalkReferenceGraph(t.Context(), client, "missing", "latest", referenceDirectionBoth, defaultReferenceDepth); err == nil {
+		t.Fatal("expected error on a missing subject, got nil")
+	}
+}
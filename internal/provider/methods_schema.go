@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const schemaRegistryContentType = "application/vnd.schemaregistry.v1+json"
+
+// RegisterSchema registers the schema under the subject. Registering a schema that
+// already exists in the subject returns its id without creating a new version.
+func RegisterSchema(ctx context.Context, client *Client, subject_name string, payload RegisterSchemaRequest, normalize bool) (*RegisterSchemaResponse, error) {
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/subjects/%s/versions?normalize=%t", client.HostURL, url.PathEscape(subject_name), normalize), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", schemaRegistryContentType)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to register schema under subject '%s'", subject_name))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response RegisterSchemaResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// LookupSchema returns the version of the subject the schema is registered as, or nil when it is not registered.
+func LookupSchema(ctx context.Context, client *Client, subject_name string, payload RegisterSchemaRequest, normalize bool) (*SchemaResponse, error) {
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/subjects/%s?normalize=%t", client.HostURL, url.PathEscape(subject_name), normalize), bytes.NewReader(rb))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", schemaRegistryContentType)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// Subject or schema does not exist
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to look up schema under subject '%s'", subject_name))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SchemaResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetSchemaVersion returns a version of the subject, where version is either a number or "latest".
+func GetSchemaVersion(ctx context.Context, client *Client, subject_name string, version string, deleted bool) (*SchemaResponse, error) {
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/subjects/%s/versions/%s?deleted=%t", client.HostURL, url.PathEscape(subject_name), url.PathEscape(version), deleted), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// Subject or version does not exist
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to get version '%s' of subject '%s'", version, subject_name))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SchemaResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// GetSchemaByID returns the schema registered with the id. Subject and version are not set. Ids outside of the
+// default context are looked up with a subject of their context, e.g. ":.staging:".
+func GetSchemaByID(ctx context.Context, client *Client, id int64, subject_name string) (*SchemaResponse, error) {
+
+	endpoint := fmt.Sprintf("%s/schemas/ids/%d", client.HostURL, id)
+	if subject_name != "" {
+		endpoint += "?subject=" + url.QueryEscape(subject_name)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// Schema does not exist
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, fmt.Sprintf("failed to get schema with id %d", id))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SchemaResponse
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	response.ID = int(id)
+
+	return &response, nil
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSchemaByIDContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// Ids are registered per context
+		if r.URL.Path == "/schemas/ids/1" && r.URL.Query().Get("subject") == ":.staging:" {
+			_, _ = fmt.Fprint(w, `{"schema":"\"string\""}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"error_code":40403,"message":"Schema not found"}`)
+	}))
+	t.Cleanup(server.Close)
+	client := newTestClient(t, server.URL)
+
+	schema, err := GetSchemaByID(t.Context(), client, 1, ":.staging:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema == nil || schema.ID != 1 || schema.Schema != `"string"` {
+		t.Fatalf("unexpected schema of the staging context: %+v", schema)
+	}
+
+	schema, err = GetSchemaByID(t.Context(), client, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema != nil {
+		t.Fatalf("expected no schema in the default context, got %+v", schema)
+	}
+}
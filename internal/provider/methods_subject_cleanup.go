@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"slices"
 	"sort"
 	"time"
@@ -19,20 +21,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func ListSubjectVersions(client *Client, subject_name string, deleted bool) ([]int, error) {
+func ListSubjectVersions(ctx context.Context, client *Client, subject_name string, deleted bool) ([]int, error) {
 
 	if subject_name == "" {
 		return nil, fmt.Errorf("subject name not configured")
 	}
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/subjects/%s/versions?deleted=%t", client.HostURL, subject_name, deleted), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/subjects/%s/versions?deleted=%t", client.HostURL, url.PathEscape(subject_name), deleted), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(client.Auth.Username, client.Auth.Password)
-
-	res, err := client.HTTPClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func ListSubjectVersions(client *Client, subject_name string, deleted bool) ([]i
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to get subject versions. Response code %d", res.StatusCode)
+		return nil, newAPIError(res, fmt.Sprintf("failed to get subject '%s' versions", subject_name))
 	}
 
 	body, err := io.ReadAll(res.Body)
@@ -62,35 +62,33 @@ func ListSubjectVersions(client *Client, subject_name string, deleted bool) ([]i
 	return response, nil
 }
 
-func DeleteSchemaVersion(client *Client, subject_name string, version int, permanent bool) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/subjects/%s/versions/%d?permanent=%t",
-		client.HostURL, subject_name, version, permanent), nil)
+func DeleteSchemaVersion(ctx context.Context, client *Client, subject_name string, version int, permanent bool) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/subjects/%s/versions/%d?permanent=%t",
+		client.HostURL, url.PathEscape(subject_name), version, permanent), nil)
 	if err != nil {
 		return err
 	}
 
-	req.SetBasicAuth(client.Auth.Username, client.Auth.Password)
-
-	res, err := client.HTTPClient.Do(req)
+	res, err := client.Do(req)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
-		return fmt.Errorf("unexpected response code received on deleting schema version '%d' for subject '%s'. Expected [%d, %d] Received %d", version, subject_name, http.StatusOK, http.StatusNotFound, res.StatusCode)
+		return newAPIError(res, fmt.Sprintf("failed to delete schema version '%d' for subject '%s'", version, subject_name))
 	}
 
 	return nil
 }
 
-func GetSchemaVersions(model subjectCleanupResourceModel, client *Client) ([]int, []int, []int, error) {
-	all, err := ListSubjectVersions(client, model.SubjectName.ValueString(), true)
+func GetSchemaVersions(ctx context.Context, model subjectCleanupResourceModel, client *Client) ([]int, []int, []int, error) {
+	all, err := ListSubjectVersions(ctx, client, model.subject(), true)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	active, err := ListSubjectVersions(client, model.SubjectName.ValueString(), false)
+	active, err := ListSubjectVersions(ctx, client, model.subject(), false)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -108,48 +106,91 @@ func GetSchemaVersions(model subjectCleanupResourceModel, client *Client) ([]int
 	return all, active, softDeleted, nil
 }
 
-func DeleteSchemaVersions(versions *[]int, ctx context.Context, client *Client, model subjectCleanupResourceModel, soft bool) error {
+// DeleteSchemaVersions deletes the versions of the subject. Versions the registry refuses to delete because
+// other schemas still reference them are removed from versions and returned instead of failing.
+func DeleteSchemaVersions(ctx context.Context, versions *[]int, client *Client, model subjectCleanupResourceModel, soft bool) ([]int, error) {
+	var deleted, skipped []int
+
 	for _, v := range *versions {
-		tflog.Debug(ctx, fmt.Sprintf("Deleting %s version %v", model.SubjectName.ValueString(), v))
+		// Stop between deletions if apply has been interrupted
+		if err := ctx.Err(); err != nil {
+			*versions = deleted
+			return skipped, fmt.Errorf("schema versions deletion interrupted before deleting version %d: %s", v, err.Error())
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Deleting %s version %v", model.subject(), v))
 		if soft {
-			err := DeleteSchemaVersion(client, model.SubjectName.ValueString(), v, false)
+			err := DeleteSchemaVersion(ctx, client, model.subject(), v, false)
+			if isReferenceExistsError(err) {
+				tflog.Warn(ctx, fmt.Sprintf("Skipping %s version %v still referenced by other schemas", model.subject(), v))
+				skipped = append(skipped, v)
+				continue
+			}
 			if err != nil {
-				return fmt.Errorf("could not soft delete schema version. Unexpected error: %s", err.Error())
+				*versions = deleted
+				return skipped, fmt.Errorf("could not soft delete schema version: %w", err)
 			}
 		}
 
-		err := DeleteSchemaVersion(client, model.SubjectName.ValueString(), v, true)
+		err := DeleteSchemaVersion(ctx, client, model.subject(), v, true)
+		if isReferenceExistsError(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Skipping %s version %v still referenced by other schemas", model.subject(), v))
+			skipped = append(skipped, v)
+			continue
+		}
 		if err != nil {
-			return fmt.Errorf("could not hard delete schema version. Unexpected error: %s", err.Error())
+			*versions = deleted
+			return skipped, fmt.Errorf("could not hard delete schema version: %w", err)
 		}
 
+		deleted = append(deleted, v)
 	}
-	return nil
+
+	*versions = deleted
+	return skipped, nil
+}
+
+// isReferenceExistsError reports whether a delete has been rejected because other schemas reference the version.
+func isReferenceExistsError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode == fmt.Sprintf("%d", srErrorCodeReferenceExists)
 }
 
-func SubjectCleanup(ctx context.Context, client *Client, model *subjectCleanupResourceModel) (diag.Diagnostics, error) {
+func SubjectCleanup(ctx context.Context, clients *providerClients, model *subjectCleanupResourceModel) (diag.Diagnostics, error) {
 	var subjectVersions schemaVersions
 	var diags diag.Diagnostics
 	var latestVersion int
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: model.RestEndpoint,
+		Registry:     model.Registry,
 		Credentials:  model.Credentials,
+		TLS:          model.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(clients, &creds)
 	if err != nil {
 		return diags, err
 	}
 
 	subjectVersions.client = schemaAPIClient
-	err = subjectVersions.get(*model)
+	err = subjectVersions.get(ctx, *model)
 	if err != nil {
 		return diags, err
 	}
 
 	subjectVersions.countSchemasToKeep(*model)
-	subjectVersions.calculateDeleteCandidates()
+	err = subjectVersions.calculateDeleteCandidates(ctx, *model)
+	if err != nil {
+		return diags, err
+	}
+
+	// Only the versions shown in the plan are deleted
+	diags = subjectVersions.restrictToPlanned(ctx, *model)
+	if diags.HasError() {
+		return diags, nil
+	}
+
 	err = subjectVersions.cleanDeleteCandidates(ctx, *model)
 	if err != nil {
 		return diags, err
@@ -166,37 +207,59 @@ func SubjectCleanup(ctx context.Context, client *Client, model *subjectCleanupRe
 		lastDeleted = append(lastDeleted, types.Int32Value(int32(id)))
 	}
 
+	var skippedReferenced []attr.Value
+	for _, id := range subjectVersions.skippedReferenced {
+		skippedReferenced = append(skippedReferenced, types.Int32Value(int32(id)))
+	}
+
 	model.SchemasToKeep = types.Int64Value(int64(subjectVersions.schemasToKeep))
 	model.LastSchemaVersion = types.Int32Value(int32(latestVersion))
 	model.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 	model.CleanupNeeded = types.BoolValue(false)
 	model.LastDeleted, diags = types.ListValue(types.Int32Type, lastDeleted)
+	if diags.HasError() {
+		return diags, nil
+	}
+	model.SkippedReferenced, diags = types.ListValue(types.Int32Type, skippedReferenced)
+	if diags.HasError() {
+		return diags, nil
+	}
+
+	// Deletions are listed during the plan unless the connection is only known on apply
+	if model.PlannedDeletions.IsUnknown() {
+		model.PlannedDeletions, diags = types.ListValue(types.Int32Type, []attr.Value{})
+	}
 
 	return diags, nil
 }
 
-func ReadSubjectVersions(ctx context.Context, client *Client, model subjectCleanupResourceModel) (schemaVersions, error) {
+func ReadSubjectVersions(ctx context.Context, clients *providerClients, model subjectCleanupResourceModel) (schemaVersions, error) {
 
 	var subjectVersions schemaVersions
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: model.RestEndpoint,
+		Registry:     model.Registry,
 		Credentials:  model.Credentials,
+		TLS:          model.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(clients, &creds)
 	if err != nil {
 		return subjectVersions, err
 	}
 
 	subjectVersions.client = schemaAPIClient
-	err = subjectVersions.get(model)
+	err = subjectVersions.get(ctx, model)
 	if err != nil {
 		return subjectVersions, err
 	}
 
 	subjectVersions.countSchemasToKeep(model)
-	subjectVersions.calculateDeleteCandidates()
+	err = subjectVersions.calculateDeleteCandidates(ctx, model)
+	if err != nil {
+		return subjectVersions, err
+	}
 
 	return subjectVersions, nil
 }
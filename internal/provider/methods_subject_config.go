@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// UpdateSubjectConfig sets the fields of the request. Fields that are not set keep their current value.
+func UpdateSubjectConfig(ctx context.Context, client *Client, subject_name string, payload SubjectConfigRequest) error {
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/config/%s", client.HostURL, url.PathEscape(subject_name)), bytes.NewReader(rb))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", schemaRegistryContentType)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return newAPIError(res, fmt.Sprintf("failed to update subject '%s' configuration", subject_name))
+	}
+
+	return nil
+}
+
+// ClearSubjectConfigFields removes the fields from the subject config. Schema Registry can not unset a
+// single field, so the config is deleted and the remaining fields are set again. A compatibility level
+// equal to the global one is treated as inherited unless it is listed in keep.
+//
+// Deletion and update are not atomic. A failed update or a concurrent writer in between can lose the
+// remaining fields, so they are read back after the update and an error lists the fields that are lost.
+func ClearSubjectConfigFields(ctx context.Context, client *Client, subject_name string, attrs []string, keep []string) error {
+	subjectConfig, err := GetSubjectConfig(ctx, client, subject_name)
+	if err != nil {
+		return err
+	}
+	if subjectConfig == nil {
+		return nil
+	}
+
+	current := parseResponseAttrs(subjectConfig)
+	if !slices.ContainsFunc(attrs, func(attr string) bool { return slices.Contains(current, attr) }) {
+		return nil
+	}
+
+	schemaRegistryConfig, err := GetSubjectConfig(ctx, client, "")
+	if err != nil {
+		return err
+	}
+
+	remaining := *subjectConfig
+	for _, attr := range attrs {
+		remaining.clear(attr)
+	}
+
+	if !slices.Contains(keep, "compatibilityLevel") && remaining.CompatibilityLevel != nil &&
+		schemaRegistryConfig != nil && schemaRegistryConfig.CompatibilityLevel != nil &&
+		*remaining.CompatibilityLevel == *schemaRegistryConfig.CompatibilityLevel {
+		remaining.CompatibilityLevel = nil
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting entire %s subject config", subject_name))
+	err = DeleteSubjectConfig(ctx, client, subject_name)
+	if err != nil {
+		return err
+	}
+
+	if len(parseResponseAttrs(&remaining)) == 0 {
+		return nil
+	}
+
+	restored := parseResponseAttrs(&remaining)
+	tflog.Debug(ctx, fmt.Sprintf("Restoring %v fields of %s subject config", restored, subject_name))
+	err = UpdateSubjectConfig(ctx, client, subject_name, remaining.request())
+	if err != nil {
+		return fmt.Errorf("config of subject '%s' was deleted but fields %v could not be set again: %w", subject_name, restored, err)
+	}
+
+	updated, err := GetSubjectConfig(ctx, client, subject_name)
+	if err != nil {
+		return fmt.Errorf("could not verify restored fields %v of subject '%s' config: %w", restored, subject_name, err)
+	}
+
+	var lost []string
+	for _, attr := range restored {
+		if updated == nil || !slices.Contains(parseResponseAttrs(updated), attr) {
+			lost = append(lost, attr)
+		}
+	}
+	if len(lost) > 0 {
+		return fmt.Errorf("config of subject '%s' changed while it was rewritten, fields %v are lost", subject_name, lost)
+	}
+
+	return nil
+}
+
+// CheckSubjectAlias refuses to set an alias on a subject that has its own versions, they would be
+// shadowed by the versions of the aliased subject.
+func CheckSubjectAlias(ctx context.Context, client *Client, subject_name string) error {
+	versions, err := ListSubjectVersions(ctx, client, subject_name, false)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) > 0 {
+		return fmt.Errorf("subject '%s' has %d versions of its own. Only subjects without versions can be an alias", subject_name, len(versions))
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newSubjectConfigServer serves the subject and global configs and records config updates and deletions.
+func newSubjectConfigServer(t *testing.T, subjectConfig string) (*httptest.Server, *[]string) {
+	t.Helper()
+
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/config/":
+			_, _ = fmt.Fprint(w, `{"compatibilityLevel":"BACKWARD"}`)
+		case r.Method == "GET" && r.URL.Path == "/config/test":
+			_, _ = fmt.Fprint(w, subjectConfig)
+		case r.Method == "DELETE" && r.URL.Path == "/config/test":
+			requests = append(requests, "DELETE")
+			_, _ = fmt.Fprint(w, subjectConfig)
+		case r.Method == "PUT" && r.URL.Path == "/config/test":
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, "PUT "+string(body))
+			_, _ = w.Write(body)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestClearSubjectConfigFieldsRestoresRemainingFields(t *testing.T) {
+	subjectConfig := `{"alias":"other","normalize":true,"compatibilityLevel":"BACKWARD","defaultRuleSet":{"domainRules":[{"name":"r","kind":"CONDITION"}]}}`
+
+	server, requests := newSubjectConfigServer(t, subjectConfig)
+	client := newTestClient(t, server.URL)
+
+	if err := ClearSubjectConfigFields(t.Context(), client, "test", []string{"alias"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Compatibility level equal to the global one is inherited
+	expected := []string{
+		"DELETE",
+		`PUT {"normalize":true,"defaultRuleSet":{"domainRules":[{"name":"r","kind":"CONDITION"}]}}`,
+	}
+	if !reflect.DeepEqual(*requests, expected) {
+		t.Fatalf("unexpected requests: got %v, want %v", *requests, expected)
+	}
+}
+
+func TestClearSubjectConfigFieldsKeepsManagedCompatibility(t *testing.T) {
+	server, requests := newSubjectConfigServer(t, `{"normalize":true,"compatibilityLevel":"BACKWARD"}`)
+	client := newTestClient(t, server.URL)
+
+	if err := ClearSubjectConfigFields(t.Context(), client, "test", []string{"normalize"}, []string{"compatibilityLevel"}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"DELETE", `PUT {"compatibility":"BACKWARD"}`}
+	if !reflect.DeepEqual(*requests, expected) {
+		t.Fatalf("unexpected requests: got %v, want %v", *requests, expected)
+	}
+}
+
+func TestClearSubjectConfigFieldsRemovesCompatibility(t *testing.T) {
+	server, requests := newSubjectConfigServer(t, `{"normalize":true,"compatibilityLevel":"FULL"}`)
+	client := newTestClient(t, server.URL)
+
+	if err := ClearSubjectConfigFields(t.Context(), client, "test", []string{"compatibilityLevel"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"DELETE", `PUT {"normalize":true}`}
+	if !reflect.DeepEqual(*requests, expected) {
+		t.Fatalf("unexpected requests: got %v, want %v", *requests, expected)
+	}
+}
+
+func TestClearSubjectConfigFieldsDeletesEmptyConfig(t *testing.T) {
+	server, requests := newSubjectConfigServer(t, `{"normalize":true,"compatibilityLevel":"BACKWARD"}`)
+	client := newTestClient(t, server.URL)
+
+	// Fields that are not set are not cleared
+	if err := ClearSubjectConfigFields(t.Context(), client, "test", []string{"alias"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(*requests) != 0 {
+		t.Fatalf("unexpected requests: %v", *requests)
+	}
+
+	if err := ClearSubjectConfigFields(t.Context(), client, "test", []string{"normalize"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*requests, []string{"DELETE"}) {
+		t.Fatalf("unexpected requests: got %v, want %v", *requests, []string{"DELETE"})
+	}
+}
+
+func TestClearSubjectConfigFieldsDetectsLostFields(t *testing.T) {
+	// A concurrent writer deletes the config right after it has been set again
+	var updated bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/config/test" && updated:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "GET":
+			_, _ = fmt.Fprint(w, `{"normalize":true,"alias":"other"}`)
+		case r.Method == "PUT":
+			updated = true
+			_, _ = fmt.Fprint(w, `{"normalize":true}`)
+		default:
+			_, _ = fmt.Fprint(w, `{}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	err := ClearSubjectConfigFields(t.Context(), newTestClient(t, server.URL), "test", []string{"alias"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "fields [normalize] are lost") {
+		t.Fatalf("expected lost fields error, got %v", err)
+	}
+}
+
+func TestCheckSubjectAlias(t *testing.T) {
+	client := newTestClient(t, newModeServer(t, `["orders"]`).URL)
+
+	if err := CheckSubjectAlias(t.Context(), client, "orders"); err == nil {
+		t.Fatal("expected error on alias for a subject with versions, got nil")
+	}
+
+	if err := CheckSubjectAlias(t.Context(), client, "empty"); err != nil {
+		t.Fatalf("unexpected error on alias for a subject without versions: %s", err)
+	}
+
+	if err := CheckSubjectAlias(t.Context(), client, "missing"); err != nil {
+		t.Fatalf("unexpected error on alias for a missing subject: %s", err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+)
+
+// ListSubjects returns subjects starting with the prefix. Deleted includes soft-deleted subjects.
+func ListSubjects(ctx context.Context, client *Client, prefix string, deleted bool) ([]string, error) {
+	query := url.Values{}
+	if prefix != "" {
+		query.Set("subjectPrefix", prefix)
+	}
+	query.Set("deleted", fmt.Sprintf("%t", deleted))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/subjects?%s", client.HostURL, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res, "failed to list subjects")
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []string
+
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// FilterSubjects returns the sorted subjects matching the filter. Soft-deleted only keeps subjects
+// listed with deleted subjects but not among the active ones.
+func FilterSubjects(ctx context.Context, client *Client, prefix string, deleted bool, softDeletedOnly bool, filter *regexp.Regexp) ([]string, error) {
+	subjects, err := ListSubjects(ctx, client, prefix, deleted || softDeletedOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	active := map[string]struct{}{}
+	if softDeletedOnly {
+		activeSubjects, err := ListSubjects(ctx, client, prefix, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, subject := range activeSubjects {
+			active[subject] = struct{}{}
+		}
+	}
+
+	filtered := []string{}
+	for _, subject := range subjects {
+		if _, ok := active[subject]; ok {
+			continue
+		}
+		if filter != nil && !filter.MatchString(subject) {
+			continue
+		}
+		filtered = append(filtered, subject)
+	}
+
+	slices.Sort(filtered)
+
+	return slices.Compact(filtered), nil
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func newSubjectsServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	active := []string{"orders-value", "payments-value", "orders-key"}
+	deleted := []string{"orders-archive-value"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/subjects" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		subjects := active
+		if r.URL.Query().Get("deleted") == "true" {
+			subjects = append(append([]string{}, active...), deleted...)
+		}
+
+		var response []string
+		for _, subject := range subjects {
+			if strings.HasPrefix(subject, r.URL.Query().Get("subjectPrefix")) {
+				response = append(response, `"`+subject+`"`)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, "[%s]", strings.Join(response, ","))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestFilterSubjects(t *testing.T) {
+	client := newTestClient(t, newSubjectsServer(t).URL)
+
+	cases := []struct {
+		name            string
+		prefix          string
+		deleted         bool
+		softDeletedOnly bool
+		filter          *regexp.Regexp
+		expected        []string
+	}{
+		{"all", "", false, false, nil, []string{"orders-key", "orders-value", "payments-value"}},
+		{"prefix", "orders", false, false, nil, []string{"orders-key", "orders-value"}},
+		{"deleted", "orders", true, false, nil, []string{"orders-archive-value", "orders-key", "orders-value"}},
+		{"soft deleted only", "", false, true, nil, []string{"orders-archive-value"}},
+		{"regex", "", true, false, regexp.MustCompile(`-value$`), []string{"orders-archive-value", "orders-value", "payments-value"}},
+		{"no match", "", false, false, regexp.MustCompile(`^users`), []string{}},
+	}
+
+	for _, c := range cases {
+		subjects, err := FilterSubjects(t.Context(), client, c.prefix, c.deleted, c.softDeletedOnly, c.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(subjects, c.expected) {
+			t.Fatalf("%s: unexpected subjects: got %v, want %v", c.name, subjects, c.expected)
+		}
+	}
+}
@@ -4,66 +4,64 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 )
 
-func (c *Client) DeleteUser(userID string) error {
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
 
 	if userID == "" {
 		return fmt.Errorf("UserID is empty")
 	}
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/iam/v2/users/%s", c.HostURL, userID), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/iam/v2/users/%s", c.HostURL, url.PathEscape(userID)), nil)
 	if err != nil {
 		return err
 	}
 
-	req.SetBasicAuth(c.Auth.Username, c.Auth.Password)
-
-	res, err := c.HTTPClient.Do(req)
+	res, err := c.Do(req)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("unexpected response code received on User DELETE request. Expected %d Received %d", http.StatusNoContent, res.StatusCode)
+		return newAPIError(res, fmt.Sprintf("failed to delete user '%s'", userID))
 	}
 
 	return nil
 }
 
-func (c *Client) ReadUser(userId string) (*User, error) {
+func (c *Client) ReadUser(ctx context.Context, userId string) (*User, error) {
 
 	user := User{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/iam/v2/users/%s", c.HostURL, userId), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/iam/v2/users/%s", c.HostURL, url.PathEscape(userId)), nil)
 
 	if err != nil {
 		return nil, err
 	}
 
-	req.SetBasicAuth(c.Auth.Username, c.Auth.Password)
-
-	res, err := c.HTTPClient.Do(req)
+	res, err := c.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
 	if res.StatusCode == http.StatusNotFound {
 		return nil, nil
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected response code received on User DELETE request. Expected %d Received %d", http.StatusOK, res.StatusCode)
+		return nil, newAPIError(res, fmt.Sprintf("failed to get user '%s'", userId))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
 	}
 
 	err = json.Unmarshal(body, &user)
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "strings"
+
+// compatibilityLevels accepted by Schema Registry.
+var compatibilityLevels = []string{
+	"NONE",
+	"BACKWARD",
+	"BACKWARD_TRANSITIVE",
+	"FORWARD",
+	"FORWARD_TRANSITIVE",
+	"FULL",
+	"FULL_TRANSITIVE",
+}
+
+// defaultCompatibilityLevel is used by Schema Registry when no level is configured.
+const defaultCompatibilityLevel = "BACKWARD"
+
+type CompatibilityRequest struct {
+	Compatibility string `json:"compatibility"`
+}
+
+type CompatibilityResponse struct {
+	Compatibility string `json:"compatibility"`
+}
+
+type CompatibilityCheckResponse struct {
+	IsCompatible bool     `json:"is_compatible"`
+	Messages     []string `json:"messages"`
+}
+
+// compatibilityRule describes what a compatibility level requires from the version history.
+type compatibilityRule struct {
+	// backward requires a newer schema to read data written with an older one
+	backward bool
+	// forward requires an older schema to read data written with a newer one
+	forward bool
+	// transitive compares a version with all earlier versions instead of the previous one only
+	transitive bool
+}
+
+func newCompatibilityRule(level string) compatibilityRule {
+	return compatibilityRule{
+		backward:   strings.HasPrefix(level, "BACKWARD") || strings.HasPrefix(level, "FULL"),
+		forward:    strings.HasPrefix(level, "FORWARD") || strings.HasPrefix(level, "FULL"),
+		transitive: strings.HasSuffix(level, "_TRANSITIVE"),
+	}
+}
+
+// tightens reports whether the rule requires anything from the history that the current rule does not.
+func (r compatibilityRule) tightens(current compatibilityRule) bool {
+	if (r.backward && !current.backward) || (r.forward && !current.forward) {
+		return true
+	}
+	return r.transitive && !current.transitive && (r.backward || r.forward)
+}
+
+// evaluates reports whether compatibility checks run with the rule as the effective subject level
+// can tell if the history satisfies the required rule. A single direction level can test both
+// directions by swapping the compared versions, while a full level tests both at once.
+func (r compatibilityRule) evaluates(required compatibilityRule) bool {
+	if r.backward != r.forward {
+		return true
+	}
+	return r.backward && required.backward && required.forward
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultContext holds subjects that are not qualified with a context.
+const defaultContext = "."
+
+// qualifiedSubject returns the subject name qualified with its context, e.g. ":.staging:orders-value".
+func qualifiedSubject(schemaContext types.String, subject types.String) string {
+	if schemaContext.IsNull() || schemaContext.ValueString() == "" || schemaContext.ValueString() == defaultContext {
+		return subject.ValueString()
+	}
+	return ":" + schemaContext.ValueString() + ":" + subject.ValueString()
+}
+
+// parseQualifiedSubject splits a context qualified subject name into its context and subject name.
+// Context is empty for subjects that are not qualified.
+func parseQualifiedSubject(name string) (string, string) {
+	if !strings.HasPrefix(name, ":.") {
+		return "", name
+	}
+
+	end := strings.Index(name[1:], ":")
+	if end < 0 {
+		return "", name
+	}
+
+	return name[1 : end+1], name[end+2:]
+}
+
+// importSubject sets subject name and context of the imported resource. Import ID is the subject name,
+// optionally qualified with a context, e.g. ":.staging:orders-value".
+func importSubject(ctx context.Context, id string, resp *resource.ImportStateResponse) {
+	schemaContext, subject := parseQualifiedSubject(id)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject_name"), subject)...)
+	if schemaContext != "" && schemaContext != defaultContext {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context"), schemaContext)...)
+	}
+}
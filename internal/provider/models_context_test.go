@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestQualifiedSubject(t *testing.T) {
+	cases := []struct {
+		context  types.String
+		subject  string
+		expected string
+	}{
+		{types.StringNull(), "orders-value", "orders-value"},
+		{types.StringValue("."), "orders-value", "orders-value"},
+		{types.StringValue(".staging"), "orders-value", ":.staging:orders-value"},
+		{types.StringValue(".staging"), "team/orders value", ":.staging:team/orders value"},
+	}
+
+	for _, c := range cases {
+		qualified := qualifiedSubject(c.context, types.StringValue(c.subject))
+		if qualified != c.expected {
+			t.Fatalf("unexpected qualified subject: got '%s', want '%s'", qualified, c.expected)
+		}
+
+		schemaContext, subject := parseQualifiedSubject(qualified)
+		if subject != c.subject {
+			t.Fatalf("unexpected subject of '%s': got '%s', want '%s'", qualified, subject, c.subject)
+		}
+		if expected := c.context.ValueString(); expected != defaultContext && schemaContext != expected {
+			t.Fatalf("unexpected context of '%s': got '%s', want '%s'", qualified, schemaContext, expected)
+		}
+	}
+
+	if schemaContext, subject := parseQualifiedSubject(":.broken"); schemaContext != "" || subject != ":.broken" {
+		t.Fatalf("unexpected split of an incomplete qualified name: '%s' '%s'", schemaContext, subject)
+	}
+}
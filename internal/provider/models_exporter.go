@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// exporterContextTypes accepted by Schema Registry. AUTO exports into a context named after the
+// source registry, CUSTOM into the configured context and NONE into the default context.
+var exporterContextTypes = []string{"AUTO", "CUSTOM", "NONE"}
+
+const (
+	exporterContextCustom = "CUSTOM"
+	exporterStatePaused   = "PAUSED"
+)
+
+// Exporter config keys of the destination Schema Registry connection.
+const (
+	exporterURLConfig               = "schema.registry.url"
+	exporterCredentialsSourceConfig = "basic.auth.credentials.source"
+	exporterUserInfoConfig          = "basic.auth.user.info"
+)
+
+type ExporterRequest struct {
+	Name                string            `json:"name,omitempty"`
+	Subjects            []string          `json:"subjects"`
+	ContextType         string            `json:"contextType,omitempty"`
+	Context             string            `json:"context,omitempty"`
+	SubjectRenameFormat string            `json:"subjectRenameFormat,omitempty"`
+	Config              map[string]string `json:"config,omitempty"`
+}
+
+type ExporterResponse struct {
+	Name                string            `json:"name"`
+	Subjects            []string          `json:"subjects"`
+	ContextType         string            `json:"contextType"`
+	Context             string            `json:"context"`
+	SubjectRenameFormat string            `json:"subjectRenameFormat"`
+	Config              map[string]string `json:"config"`
+}
+
+type ExporterStatusResponse struct {
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Offset int64  `json:"offset"`
+	Ts     int64  `json:"ts"`
+	Trace  string `json:"trace"`
+}
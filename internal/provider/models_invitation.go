@@ -3,21 +3,6 @@
 
 package provider
 
-type InvitationList struct {
-	APIVersion string       `json:"api_version"`
-	Kind       string       `json:"kind"`
-	Metadata   ListMeta     `json:"metadata"`
-	Data       []Invitation `json:"data"`
-}
-
-type ListMeta struct {
-	First     string `json:"first"`
-	Last      string `json:"last"`
-	Prev      string `json:"prev"`
-	Next      string `json:"next"`
-	TotalSize int    `json:"total_size"`
-}
-
 type Invitation struct {
 	APIVersion string             `json:"api_version"`
 	Kind       string             `json:"kind"`
@@ -0,0 +1,20 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// ListPage is a single page of a Confluent Cloud list endpoint.
+type ListPage[T any] struct {
+	APIVersion string   `json:"api_version"`
+	Kind       string   `json:"kind"`
+	Metadata   ListMeta `json:"metadata"`
+	Data       []T      `json:"data"`
+}
+
+type ListMeta struct {
+	First     string `json:"first"`
+	Last      string `json:"last"`
+	Prev      string `json:"prev"`
+	Next      string `json:"next"`
+	TotalSize int    `json:"total_size"`
+}
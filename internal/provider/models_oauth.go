@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type oauthModel struct {
+	TokenURL       types.String `tfsdk:"token_url"`
+	ClientID       types.String `tfsdk:"client_id"`
+	ClientSecret   types.String `tfsdk:"client_secret"`
+	Scope          types.String `tfsdk:"scope"`
+	StaticToken    types.String `tfsdk:"static_token"`
+	IdentityPoolID types.String `tfsdk:"identity_pool_id"`
+}
+
+type schemaRegistryOAuthModel struct {
+	oauthModel
+	LogicalClusterID types.String `tfsdk:"logical_cluster_id"`
+}
+
+// authenticator returns the Schema Registry authenticator, see newAuthenticator.
+func (m *schemaRegistryOAuthModel) authenticator(key, secret string) (Authenticator, error) {
+	if m == nil {
+		return newAuthenticator(key, secret, nil, "")
+	}
+	return newAuthenticator(key, secret, &m.oauthModel, m.LogicalClusterID.ValueString())
+}
+
+// newAuthenticator returns bearer token authentication when oauth is configured,
+// basic authentication when key and secret are set, or nil otherwise.
+func newAuthenticator(key, secret string, oauth *oauthModel, logicalClusterID string) (Authenticator, error) {
+	if oauth != nil && (!oauth.StaticToken.IsNull() || !oauth.TokenURL.IsNull()) {
+		headers := IdentityHeaders{
+			IdentityPoolID:   oauth.IdentityPoolID.ValueString(),
+			LogicalClusterID: logicalClusterID,
+		}
+
+		if !oauth.StaticToken.IsNull() {
+			return &BearerTokenAuthenticator{
+				Token:   oauth.StaticToken.ValueString(),
+				Headers: headers,
+			}, nil
+		}
+
+		if oauth.ClientID.ValueString() == "" || oauth.ClientSecret.ValueString() == "" {
+			return nil, fmt.Errorf("client_id and client_secret must be set when token_url is set")
+		}
+
+		return &OAuthClientCredentialsAuthenticator{
+			TokenURL:     oauth.TokenURL.ValueString(),
+			ClientID:     oauth.ClientID.ValueString(),
+			ClientSecret: oauth.ClientSecret.ValueString(),
+			Scope:        oauth.Scope.ValueString(),
+			Headers:      headers,
+			HTTPClient: &http.Client{
+				Timeout: 10 * time.Second,
+				Transport: &http.Transport{
+					Proxy: http.ProxyFromEnvironment,
+				},
+			},
+		}, nil
+	}
+
+	if oauth != nil && (!oauth.IdentityPoolID.IsNull() || logicalClusterID != "") {
+		return nil, fmt.Errorf("either static_token or token_url must be set to use bearer token authentication")
+	}
+
+	if key == "" || secret == "" {
+		return nil, nil
+	}
+
+	return AuthStruct{
+		Username: key,
+		Password: secret,
+	}, nil
+}
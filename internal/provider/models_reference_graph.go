@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "fmt"
+
+// Directions of a reference graph walk.
+const (
+	referenceDirectionBoth         = "both"
+	referenceDirectionReferences   = "references"
+	referenceDirectionReferencedBy = "referenced_by"
+	referenceDirectionRoot         = "root"
+)
+
+// defaultReferenceDepth limits reference graph walks without a configured depth.
+const defaultReferenceDepth = 10
+
+var referenceDirections = []string{referenceDirectionBoth, referenceDirectionReferences, referenceDirectionReferencedBy}
+
+type SubjectVersion struct {
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+func (v SubjectVersion) String() string {
+	return fmt.Sprintf("%s/%d", v.Subject, v.Version)
+}
+
+// ReferenceNode is a schema version of a reference graph. Depth is the distance from the root,
+// direction tells whether the node was reached by following references or referenced-by links.
+type ReferenceNode struct {
+	SubjectVersion
+	ID        int
+	Depth     int
+	Direction string
+}
+
+// ReferenceEdge links a schema version to a schema version it references.
+type ReferenceEdge struct {
+	From SubjectVersion
+	To   SubjectVersion
+}
+
+type ReferenceGraph struct {
+	Nodes []ReferenceNode
+	Edges []ReferenceEdge
+}
@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Rule kinds, modes and actions accepted by Schema Registry.
+var (
+	ruleKinds          = []string{"TRANSFORM", "CONDITION"}
+	domainRuleModes    = []string{"WRITE", "READ", "WRITEREAD"}
+	migrationRuleModes = []string{"UPGRADE", "DOWNGRADE", "UPDOWN"}
+	// ruleTwoWayModes accept an action per direction, e.g. "NONE,ERROR".
+	ruleTwoWayModes = []string{"WRITEREAD", "UPDOWN"}
+	// ruleExpressionTypes are the rule types evaluating the expr attribute.
+	ruleExpressionTypes = []string{"CEL", "CEL_FIELD", "JSONATA"}
+)
+
+// Rule is a data contract rule. Domain rules apply when a schema is written or read,
+// migration rules when a schema is upgraded or downgraded to another version.
+type Rule struct {
+	Name      string            `json:"name"`
+	Kind      string            `json:"kind"`
+	Mode      string            `json:"mode"`
+	Type      string            `json:"type"`
+	Expr      string            `json:"expr,omitempty"`
+	Params    map[string]string `json:"params,omitempty"`
+	OnSuccess string            `json:"onSuccess,omitempty"`
+	OnFailure string            `json:"onFailure,omitempty"`
+}
+
+type RuleSet struct {
+	DomainRules    []Rule `json:"domainRules,omitempty"`
+	MigrationRules []Rule `json:"migrationRules,omitempty"`
+}
+
+// parseRuleSet decodes a rule set of a config. Missing and empty rule sets are returned as nil.
+func parseRuleSet(raw *json.RawMessage) (*RuleSet, error) {
+	if raw == nil || string(*raw) == "null" {
+		return nil, nil
+	}
+
+	var ruleSet RuleSet
+	if err := json.Unmarshal(*raw, &ruleSet); err != nil {
+		return nil, err
+	}
+
+	if len(ruleSet.DomainRules) == 0 && len(ruleSet.MigrationRules) == 0 {
+		return nil, nil
+	}
+
+	return &ruleSet, nil
+}
+
+// raw encodes the rule set as a config field.
+func (r RuleSet) raw() (*json.RawMessage, error) {
+	rb, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := json.RawMessage(rb)
+	return &raw, nil
+}
+
+// names returns the rule names in the order they are applied.
+func (r *RuleSet) names() []string {
+	if r == nil {
+		return nil
+	}
+
+	var names []string
+	for _, rule := range r.DomainRules {
+		names = append(names, "domain:"+rule.Name)
+	}
+	for _, rule := range r.MigrationRules {
+		names = append(names, "migration:"+rule.Name)
+	}
+	return names
+}
+
+// replacesRules reports whether the rule set can not be reached by merging it into the current one.
+// Schema Registry merges rules by name, rules are only added at the end of the lists and never removed.
+func (r *RuleSet) replacesRules(current *RuleSet) bool {
+	if current == nil {
+		return false
+	}
+
+	domain := len(current.DomainRules)
+	migration := len(current.MigrationRules)
+	if domain > len(r.DomainRules) || migration > len(r.MigrationRules) {
+		return true
+	}
+
+	planned := RuleSet{DomainRules: r.DomainRules[:domain], MigrationRules: r.MigrationRules[:migration]}
+	return !slices.Equal(planned.names(), current.names())
+}
+
+type ruleModel struct {
+	Name      types.String `tfsdk:"name"`
+	Kind      types.String `tfsdk:"kind"`
+	Mode      types.String `tfsdk:"mode"`
+	Type      types.String `tfsdk:"type"`
+	Expr      types.String `tfsdk:"expr"`
+	Params    types.Map    `tfsdk:"params"`
+	OnSuccess types.String `tfsdk:"on_success"`
+	OnFailure types.String `tfsdk:"on_failure"`
+}
+
+func (m ruleModel) rule(ctx context.Context) (Rule, diag.Diagnostics) {
+	rule := Rule{
+		Name:      m.Name.ValueString(),
+		Kind:      m.Kind.ValueString(),
+		Mode:      m.Mode.ValueString(),
+		Type:      m.Type.ValueString(),
+		Expr:      m.Expr.ValueString(),
+		OnSuccess: m.OnSuccess.ValueString(),
+		OnFailure: m.OnFailure.ValueString(),
+	}
+
+	var diags diag.Diagnostics
+	if !m.Params.IsNull() {
+		diags = m.Params.ElementsAs(ctx, &rule.Params, false)
+	}
+
+	return rule, diags
+}
+
+// newRuleModel converts a rule, optional fields that are not set are null.
+func newRuleModel(ctx context.Context, rule Rule) (ruleModel, diag.Diagnostics) {
+	optional := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(value)
+	}
+
+	model := ruleModel{
+		Name:      types.StringValue(rule.Name),
+		Kind:      types.StringValue(rule.Kind),
+		Mode:      types.StringValue(rule.Mode),
+		Type:      types.StringValue(rule.Type),
+		Expr:      optional(rule.Expr),
+		Params:    types.MapNull(types.StringType),
+		OnSuccess: optional(rule.OnSuccess),
+		OnFailure: optional(rule.OnFailure),
+	}
+
+	var diags diag.Diagnostics
+	if len(rule.Params) > 0 {
+		model.Params, diags = types.MapValueFrom(ctx, types.StringType, rule.Params)
+	}
+
+	return model, diags
+}
+
+func rulesFromModels(ctx context.Context, models []ruleModel) ([]Rule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var rules []Rule
+
+	for _, model := range models {
+		rule, d := model.rule(ctx)
+		diags.Append(d...)
+		rules = append(rules, rule)
+	}
+
+	return rules, diags
+}
+
+func ruleModelsFrom(ctx context.Context, rules []Rule) ([]ruleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	models := []ruleModel{}
+
+	for _, rule := range rules {
+		model, d := newRuleModel(ctx, rule)
+		diags.Append(d...)
+		models = append(models, model)
+	}
+
+	return models, diags
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseRuleSet(t *testing.T) {
+	raw := json.RawMessage(`{"domainRules":[{"name":"check-ssn","kind":"CONDITION","mode":"WRITE","type":"CEL","expr":"message.ssn != ''","params":{"dlq.topic":"bad"},"onFailure":"DLQ","tags":["PII"]}]}`)
+
+	ruleSet, err := parseRuleSet(&raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &RuleSet{
+		DomainRules: []Rule{{
+			Name:      "check-ssn",
+			Kind:      "CONDITION",
+			Mode:      "WRITE",
+			Type:      "CEL",
+			Expr:      "message.ssn != ''",
+			Params:    map[string]string{"dlq.topic": "bad"},
+			OnFailure: "DLQ",
+		}},
+	}
+	if !reflect.DeepEqual(ruleSet, expected) {
+		t.Fatalf("unexpected rule set: got %+v, want %+v", ruleSet, expected)
+	}
+
+	for _, value := range []string{`null`, `{}`, `{"domainRules":[],"migrationRules":[]}`} {
+		raw = json.RawMessage(value)
+		if ruleSet, err = parseRuleSet(&raw); err != nil || ruleSet != nil {
+			t.Fatalf("unexpected rule set for %s: %+v (error %v)", value, ruleSet, err)
+		}
+	}
+}
+
+func TestRuleSetReplacesRules(t *testing.T) {
+	rules := func(names ...string) []Rule {
+		var rules []Rule
+		for _, name := range names {
+			rules = append(rules, Rule{Name: name})
+		}
+		return rules
+	}
+
+	current := &RuleSet{DomainRules: rules("a", "b"), MigrationRules: rules("m")}
+
+	cases := []struct {
+		name     string
+		planned  RuleSet
+		replaces bool
+	}{
+		{"no current rule set", RuleSet{DomainRules: rules("a")}, false},
+		{"appended rules", RuleSet{DomainRules: rules("a", "b", "c"), MigrationRules: rules("m", "n")}, false},
+		{"removed rule", RuleSet{DomainRules: rules("a"), MigrationRules: rules("m")}, true},
+		{"reordered rules", RuleSet{DomainRules: rules("b", "a"), MigrationRules: rules("m")}, true},
+		{"renamed rule", RuleSet{DomainRules: rules("a", "c"), MigrationRules: rules("m")}, true},
+		{"removed migration rules", RuleSet{DomainRules: rules("a", "b")}, true},
+	}
+
+	for _, c := range cases {
+		base := current
+		if c.name == "no current rule set" {
+			base = nil
+		}
+		if replaces := c.planned.replacesRules(base); replaces != c.replaces {
+			t.Fatalf("%s: unexpected result: got %t, want %t", c.name, replaces, c.replaces)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	schemaTypeAvro     = "AVRO"
+	schemaTypeJSON     = "JSON"
+	schemaTypeProtobuf = "PROTOBUF"
+)
+
+type SchemaReference struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+type SchemaMetadata struct {
+	Tags       map[string][]string `json:"tags,omitempty"`
+	Properties map[string]string   `json:"properties,omitempty"`
+	Sensitive  []string            `json:"sensitive,omitempty"`
+}
+
+type RegisterSchemaRequest struct {
+	Schema     string            `json:"schema"`
+	SchemaType string            `json:"schemaType,omitempty"`
+	References []SchemaReference `json:"references,omitempty"`
+	Metadata   *SchemaMetadata   `json:"metadata,omitempty"`
+	RuleSet    json.RawMessage   `json:"ruleSet,omitempty"`
+}
+
+type RegisterSchemaResponse struct {
+	ID int `json:"id"`
+}
+
+type SchemaResponse struct {
+	Subject    string            `json:"subject"`
+	ID         int               `json:"id"`
+	Version    int               `json:"version"`
+	SchemaType string            `json:"schemaType"`
+	Schema     string            `json:"schema"`
+	References []SchemaReference `json:"references"`
+	Metadata   *SchemaMetadata   `json:"metadata"`
+	RuleSet    json.RawMessage   `json:"ruleSet"`
+}
+
+// Type returns the schema type. Schema Registry omits it for AVRO schemas.
+func (r *SchemaResponse) Type() string {
+	if r.SchemaType == "" {
+		return schemaTypeAvro
+	}
+	return r.SchemaType
+}
+
+// Fingerprint returns the SHA-256 of the canonical schema. AVRO and JSON schemas are compacted with sorted
+// keys, whitespace of PROTOBUF schemas is collapsed, so formatting changes keep the fingerprint.
+func (r *SchemaResponse) Fingerprint() string {
+	canonical := strings.Join(strings.Fields(r.Schema), " ")
+
+	// Numbers are kept as written, large integers such as long defaults would lose precision as float64
+	var document interface{}
+	decoder := json.NewDecoder(strings.NewReader(r.Schema))
+	decoder.UseNumber()
+	if r.Type() != schemaTypeProtobuf && decoder.Decode(&document) == nil && !decoder.More() {
+		if compact, err := json.Marshal(document); err == nil {
+			canonical = string(compact)
+		}
+	}
+
+	sum := sha256.Sum256([]byte(r.Type() + "\n" + canonical))
+	return hex.EncodeToString(sum[:])
+}
+
+type schemaMetadataModel struct {
+	Properties types.Map `tfsdk:"properties"`
+	Tags       types.Map `tfsdk:"tags"`
+	Sensitive  types.Set `tfsdk:"sensitive"`
+}
+
+// newSchemaMetadataModel converts Schema Registry metadata into a metadata block. Nil metadata is an absent block.
+func newSchemaMetadataModel(ctx context.Context, metadata *SchemaMetadata) (*schemaMetadataModel, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	if metadata == nil {
+		return nil, diags
+	}
+
+	model := schemaMetadataModel{
+		Properties: types.MapNull(types.StringType),
+		Tags:       types.MapNull(types.ListType{ElemType: types.StringType}),
+		Sensitive:  types.SetNull(types.StringType),
+	}
+	if len(metadata.Properties) > 0 {
+		model.Properties, d = types.MapValueFrom(ctx, types.StringType, metadata.Properties)
+		diags.Append(d...)
+	}
+	if len(metadata.Tags) > 0 {
+		model.Tags, d = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, metadata.Tags)
+		diags.Append(d...)
+	}
+	if len(metadata.Sensitive) > 0 {
+		model.Sensitive, d = types.SetValueFrom(ctx, types.StringType, metadata.Sensitive)
+		diags.Append(d...)
+	}
+
+	return &model, diags
+}
+
+// metadata converts the metadata block into Schema Registry metadata. Absent block is nil metadata.
+func (m *schemaMetadataModel) metadata(ctx context.Context) (*SchemaMetadata, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m == nil {
+		return nil, diags
+	}
+
+	metadata := SchemaMetadata{}
+	if !m.Properties.IsNull() {
+		diags.Append(m.Properties.ElementsAs(ctx, &metadata.Properties, false)...)
+	}
+	if !m.Tags.IsNull() {
+		diags.Append(m.Tags.ElementsAs(ctx, &metadata.Tags, false)...)
+	}
+	if !m.Sensitive.IsNull() {
+		diags.Append(m.Sensitive.ElementsAs(ctx, &metadata.Sensitive, false)...)
+	}
+
+	return &metadata, diags
+}
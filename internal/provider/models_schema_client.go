@@ -4,7 +4,11 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -14,7 +18,9 @@ import (
 
 type schemaRegistryCredentials struct {
 	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
 	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
 }
 
 func (config *schemaRegistryCredentials) ValidateResourceConfig(resp *resource.ValidateConfigResponse) {
@@ -47,14 +53,14 @@ func (config *schemaRegistryCredentials) ValidateResourceConfig(resp *resource.V
 		}
 		if config.Credentials.Key.IsNull() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("credentials.key"),
+				path.Root("credentials").AtName("key"),
 				"Missing Required Attribute \"credentials.key\"",
 				"If any of 'credentials.key', 'credentials.secret', or 'rest_endpoint' is set, all must be set.",
 			)
 		}
 		if config.Credentials.Secret.IsNull() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("credentials.secret"),
+				path.Root("credentials").AtName("secret"),
 				"Missing Required Attribute \"credentials.secret\"",
 				"If any of 'credentials.key', 'credentials.secret', or 'rest_endpoint' is set, all must be set.",
 			)
@@ -62,7 +68,7 @@ func (config *schemaRegistryCredentials) ValidateResourceConfig(resp *resource.V
 	}
 }
 
-func (config *schemaRegistryCredentials) ValidateDataSourceConfig(resp *datasource.ReadResponse) {
+func (config *schemaRegistryCredentials) ValidateDataSourceConfig(resp *datasource.ValidateConfigResponse) {
 	if config.RestEndpoint.IsNull() && config.Credentials == nil {
 		// Expected configuration without any schema registry configuration inside for a data source
 		return
@@ -92,14 +98,14 @@ func (config *schemaRegistryCredentials) ValidateDataSourceConfig(resp *datasour
 		}
 		if config.Credentials.Key.IsNull() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("credentials.key"),
+				path.Root("credentials").AtName("key"),
 				"Missing Required Attribute \"credentials.key\"",
 				"If any of 'credentials.key', 'credentials.secret', or 'rest_endpoint' is set, all must be set.",
 			)
 		}
 		if config.Credentials.Secret.IsNull() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("credentials.secret"),
+				path.Root("credentials").AtName("secret"),
 				"Missing Required Attribute \"credentials.secret\"",
 				"If any of 'credentials.key', 'credentials.secret', or 'rest_endpoint' is set, all must be set.",
 			)
@@ -107,12 +113,27 @@ func (config *schemaRegistryCredentials) ValidateDataSourceConfig(resp *datasour
 	}
 }
 
-func schemaRegistryClientFactory(providerClient *Client, model *schemaRegistryCredentials) (*Client, error) {
+func schemaRegistryClientFactory(clients *providerClients, model *schemaRegistryCredentials) (*Client, error) {
+
+	// Named registry declared in the provider
+	if model != nil && !model.Registry.IsNull() {
+		return clients.schemaRegistry(model.Registry.ValueString())
+	}
+
+	options := DefaultClientOptions()
+	if clients != nil {
+		options = clients.Options
+	}
 
 	// Local resource config takes precedence over provider client
 	if model != nil {
+		options.TLS = model.TLS.options(options.TLS)
 		if !model.RestEndpoint.IsNull() && !model.Credentials.Key.IsNull() && !model.Credentials.Secret.IsNull() {
-			schemaAPIClient, err := NewClient(model.RestEndpoint.ValueStringPointer(), model.Credentials.Key.ValueStringPointer(), model.Credentials.Secret.ValueStringPointer())
+			auth := AuthStruct{
+				Username: model.Credentials.Key.ValueString(),
+				Password: model.Credentials.Secret.ValueString(),
+			}
+			schemaAPIClient, err := NewClient(model.RestEndpoint.ValueStringPointer(), auth, options)
 			if err != nil {
 				return nil, err
 			}
@@ -120,9 +141,53 @@ func schemaRegistryClientFactory(providerClient *Client, model *schemaRegistryCr
 		}
 	}
 	// Fallback to provider client
-	if providerClient != nil {
-		return providerClient, nil
+	if clients != nil && clients.SchemaRegistryClient != nil {
+		return clients.SchemaRegistryClient, nil
 	}
 
 	return nil, fmt.Errorf("could not create schema registry client. Make sure rest endpoint and credentials are configured for this resource as there is no schema registry client either configured in the provider settings")
 }
+
+// schemaRegistry returns the client of a registry declared in the provider `schema_registries` map.
+func (clients *providerClients) schemaRegistry(name string) (*Client, error) {
+	if clients == nil {
+		return nil, fmt.Errorf("could not find schema registry '%s' as the provider is not configured", name)
+	}
+
+	client, ok := clients.SchemaRegistries[name]
+	if !ok {
+		names := make([]string, 0, len(clients.SchemaRegistries))
+		for key := range clients.SchemaRegistries {
+			names = append(names, key)
+		}
+		slices.Sort(names)
+		return nil, fmt.Errorf("schema registry '%s' is not declared in the provider schema_registries. Declared registries: [%s]", name, strings.Join(names, ", "))
+	}
+
+	return client, nil
+}
+
+// importSchemaRegistryCredentials sets the Schema Registry connection of an imported resource out of the
+// IMPORT_SCHEMA_REGISTRY_* environment variables. Provider connection is used when they are not set.
+func importSchemaRegistryCredentials(ctx context.Context, resp *resource.ImportStateResponse) {
+	restEndpoint := os.Getenv("IMPORT_SCHEMA_REGISTRY_REST_ENDPOINT")
+	if restEndpoint == "" {
+		return
+	}
+
+	if os.Getenv("IMPORT_SCHEMA_REGISTRY_API_KEY") == "" || os.Getenv("IMPORT_SCHEMA_REGISTRY_API_SECRET") == "" {
+		resp.Diagnostics.AddError(
+			"Import error",
+			"'IMPORT_SCHEMA_REGISTRY_API_KEY' and 'IMPORT_SCHEMA_REGISTRY_API_SECRET' environment variables must be configured together with 'IMPORT_SCHEMA_REGISTRY_REST_ENDPOINT'",
+		)
+		return
+	}
+
+	var credentials = &credentialsModel{
+		Key:    types.StringValue(os.Getenv("IMPORT_SCHEMA_REGISTRY_API_KEY")),
+		Secret: types.StringValue(os.Getenv("IMPORT_SCHEMA_REGISTRY_API_SECRET")),
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rest_endpoint"), types.StringValue(restEndpoint))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credentials"), credentials)...)
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaRegistryClientFactoryNamedRegistry(t *testing.T) {
+	prod, stage := "https://prod.example.com", "https://stage.example.com"
+
+	prodClient, err := NewClient(&prod, AuthStruct{Username: "prod", Password: "prod"}, DefaultClientOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	stageClient, err := NewClient(&stage, AuthStruct{Username: "stage", Password: "stage"}, DefaultClientOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clients := &providerClients{
+		SchemaRegistries: map[string]*Client{
+			"prod":  prodClient,
+			"stage": stageClient,
+		},
+		Options: DefaultClientOptions(),
+	}
+
+	client, err := schemaRegistryClientFactory(clients, &schemaRegistryCredentials{
+		RestEndpoint: types.StringNull(),
+		Registry:     types.StringValue("stage"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client != stageClient {
+		t.Fatalf("unexpected client: %s", client.HostURL)
+	}
+
+	_, err = schemaRegistryClientFactory(clients, &schemaRegistryCredentials{
+		RestEndpoint: types.StringNull(),
+		Registry:     types.StringValue("dev"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "[prod, stage]") {
+		t.Fatalf("expected error listing declared registries, got: %v", err)
+	}
+
+	if _, err = schemaRegistryClientFactory(nil, &schemaRegistryCredentials{Registry: types.StringValue("prod")}); err == nil {
+		t.Fatal("expected error on unconfigured provider, got nil")
+	}
+}
+
+func TestValidateDataSourceConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config schemaRegistryCredentials
+		errors int
+	}{
+		{name: "provider client", config: schemaRegistryCredentials{RestEndpoint: types.StringNull()}},
+		{name: "missing credentials", config: schemaRegistryCredentials{RestEndpoint: types.StringValue("http://localhost:8081")}, errors: 1},
+		{name: "missing rest endpoint", config: schemaRegistryCredentials{
+			RestEndpoint: types.StringNull(),
+			Credentials:  &credentialsModel{Key: types.StringValue("key"), Secret: types.StringNull()},
+		}, errors: 2},
+		{name: "complete", config: schemaRegistryCredentials{
+			RestEndpoint: types.StringValue("http://localhost:8081"),
+			Credentials:  &credentialsModel{Key: types.StringValue("key"), Secret: types.StringValue("secret")},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp datasource.ValidateConfigResponse
+			tt.config.ValidateDataSourceConfig(&resp)
+
+			if resp.Diagnostics.ErrorsCount() != tt.errors {
+				t.Fatalf("expected %d errors, got %v", tt.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
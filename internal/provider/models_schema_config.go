@@ -3,24 +3,105 @@
 
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"encoding/json"
 
-type SchemaConfigMetadata struct {
-	Properties map[string]interface{} `json:"properties"`
-}
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type SchemaConfigResponse struct {
-	Alias              *string               `json:"alias"`
-	Normalize          *bool                 `json:"normalize"`
-	CompatibilityLevel *string               `json:"compatibilityLevel"`
-	CompatibilityGroup *string               `json:"compatibilityGroup"`
-	DefaultMetadata    *SchemaConfigMetadata `json:"defaultMetadata"`
-	OverrideMetadata   *SchemaConfigMetadata `json:"overrideMetadata"`
-	DefaultRuleSet     *SchemaConfigMetadata `json:"defaultRuleSet"`
-	OverrideRuleSet    *SchemaConfigMetadata `json:"overrideRuleSet"`
+	Alias              *string          `json:"alias"`
+	Normalize          *bool            `json:"normalize"`
+	CompatibilityLevel *string          `json:"compatibilityLevel"`
+	CompatibilityGroup *string          `json:"compatibilityGroup"`
+	DefaultMetadata    *SchemaMetadata  `json:"defaultMetadata"`
+	OverrideMetadata   *SchemaMetadata  `json:"overrideMetadata"`
+	DefaultRuleSet     *json.RawMessage `json:"defaultRuleSet"`
+	OverrideRuleSet    *json.RawMessage `json:"overrideRuleSet"`
+}
+
+// SubjectConfigRequest updates the fields that are set and leaves the others untouched.
+type SubjectConfigRequest struct {
+	Alias              *string          `json:"alias,omitempty"`
+	Normalize          *bool            `json:"normalize,omitempty"`
+	Compatibility      *string          `json:"compatibility,omitempty"`
+	CompatibilityGroup *string          `json:"compatibilityGroup,omitempty"`
+	DefaultMetadata    *SchemaMetadata  `json:"defaultMetadata,omitempty"`
+	OverrideMetadata   *SchemaMetadata  `json:"overrideMetadata,omitempty"`
+	DefaultRuleSet     *json.RawMessage `json:"defaultRuleSet,omitempty"`
+	OverrideRuleSet    *json.RawMessage `json:"overrideRuleSet,omitempty"`
+}
+
+// request returns the update request setting every field of the config.
+func (r *SchemaConfigResponse) request() SubjectConfigRequest {
+	return SubjectConfigRequest{
+		Alias:              r.Alias,
+		Normalize:          r.Normalize,
+		Compatibility:      r.CompatibilityLevel,
+		CompatibilityGroup: r.CompatibilityGroup,
+		DefaultMetadata:    r.DefaultMetadata,
+		OverrideMetadata:   r.OverrideMetadata,
+		DefaultRuleSet:     r.DefaultRuleSet,
+		OverrideRuleSet:    r.OverrideRuleSet,
+	}
+}
+
+// clear unsets a field named as returned by parseResponseAttrs.
+func (r *SchemaConfigResponse) clear(attr string) {
+	switch attr {
+	case "alias":
+		r.Alias = nil
+	case "normalize":
+		r.Normalize = nil
+	case "compatibilityLevel":
+		r.CompatibilityLevel = nil
+	case "compatibilityGroup":
+		r.CompatibilityGroup = nil
+	case "defaultMetadata":
+		r.DefaultMetadata = nil
+	case "overrideMetadata":
+		r.OverrideMetadata = nil
+	case "defaultRuleSet":
+		r.DefaultRuleSet = nil
+	case "overrideRuleSet":
+		r.OverrideRuleSet = nil
+	}
 }
 
 type credentialsModel struct {
 	Key    types.String `tfsdk:"key"`
 	Secret types.String `tfsdk:"secret"`
 }
+
+type schemaRegistryModel struct {
+	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
+}
+
+type tlsModel struct {
+	CACertificate     types.String `tfsdk:"ca_certificate"`
+	ClientCertificate types.String `tfsdk:"client_certificate"`
+	ClientKey         types.String `tfsdk:"client_key"`
+	ServerName        types.String `tfsdk:"server_name"`
+}
+
+// options overrides provider TLS settings with the ones set on a resource level.
+func (m *tlsModel) options(defaults TLSOptions) TLSOptions {
+	if m == nil {
+		return defaults
+	}
+	if !m.CACertificate.IsNull() {
+		defaults.CACertificate = m.CACertificate.ValueString()
+	}
+	if !m.ClientCertificate.IsNull() {
+		defaults.ClientCertificate = m.ClientCertificate.ValueString()
+	}
+	if !m.ClientKey.IsNull() {
+		defaults.ClientKey = m.ClientKey.ValueString()
+	}
+	if !m.ServerName.IsNull() {
+		defaults.ServerName = m.ServerName.ValueString()
+	}
+	return defaults
+}
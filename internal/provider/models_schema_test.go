@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestSchemaFingerprint(t *testing.T) {
+	compact := SchemaResponse{SchemaType: "JSON", Schema: `{"type":"object","properties":{"id":{"type":"integer"}}}`}
+	formatted := SchemaResponse{SchemaType: "JSON", Schema: "{\n  \"properties\": {\"id\": {\"type\": \"integer\"}},\n  \"type\": \"object\"\n}"}
+
+	if compact.Fingerprint() != formatted.Fingerprint() {
+		t.Fatal("expected formatting to keep the fingerprint")
+	}
+
+	changed := SchemaResponse{SchemaType: "JSON", Schema: `{"type":"object","properties":{"id":{"type":"string"}}}`}
+	if compact.Fingerprint() == changed.Fingerprint() {
+		t.Fatal("expected a changed schema to change the fingerprint")
+	}
+
+	// Schema type is part of the fingerprint, AVRO is the default type
+	avro := SchemaResponse{Schema: `"string"`}
+	if avro.Fingerprint() != (&SchemaResponse{SchemaType: "AVRO", Schema: `"string"`}).Fingerprint() {
+		t.Fatal("expected missing schema type to be AVRO")
+	}
+	if avro.Fingerprint() == (&SchemaResponse{SchemaType: "JSON", Schema: `"string"`}).Fingerprint() {
+		t.Fatal("expected schema type to change the fingerprint")
+	}
+
+	proto := SchemaResponse{SchemaType: "PROTOBUF", Schema: "syntax = \"proto3\";\nmessage Order {\n  int32 id = 1;\n}\n"}
+	reformatted := SchemaResponse{SchemaType: "PROTOBUF", Schema: "syntax = \"proto3\";  message Order { int32 id = 1; }"}
+	if proto.Fingerprint() != reformatted.Fingerprint() {
+		t.Fatal("expected whitespace of PROTOBUF schemas to keep the fingerprint")
+	}
+
+	// Integers above 2^53 are not rounded
+	long := SchemaResponse{Schema: `{"type":"long","default":9007199254740993}`}
+	if long.Fingerprint() == (&SchemaResponse{Schema: `{"type":"long","default":9007199254740992}`}).Fingerprint() {
+		t.Fatal("expected large integer defaults to change the fingerprint")
+	}
+}
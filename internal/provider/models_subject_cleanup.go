@@ -5,8 +5,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type schemaVersions struct {
@@ -15,12 +20,14 @@ type schemaVersions struct {
 	all              []int
 	client           *Client
 	deleteCandidates []int
-	schemasToKeep    int
+	// skippedReferenced are the referenced versions left in place instead of being deleted
+	skippedReferenced []int
+	schemasToKeep     int
 }
 
-func (r *schemaVersions) get(model subjectCleanupResourceModel) error {
+func (r *schemaVersions) get(ctx context.Context, model subjectCleanupResourceModel) error {
 	var err error
-	r.all, r.active, r.softDeleted, err = GetSchemaVersions(model, r.client)
+	r.all, r.active, r.softDeleted, err = GetSchemaVersions(ctx, model, r.client)
 	return err
 }
 
@@ -37,12 +44,108 @@ func (r *schemaVersions) countSchemasToKeep(model subjectCleanupResourceModel) {
 
 func (r *schemaVersions) cleanDeleteCandidates(ctx context.Context, model subjectCleanupResourceModel) error {
 	soft := true
-	err := DeleteSchemaVersions(&r.deleteCandidates, ctx, r.client, model, soft)
+	skipped, err := DeleteSchemaVersions(ctx, &r.deleteCandidates, r.client, model, soft)
+	if len(skipped) > 0 {
+		r.skippedReferenced = append(r.skippedReferenced, skipped...)
+		slices.Sort(r.skippedReferenced)
+	}
 	return err
 }
 
-func (r *schemaVersions) calculateDeleteCandidates() {
-	if len(r.all) > r.schemasToKeep {
-		r.deleteCandidates = r.all[:len(r.all)-r.schemasToKeep]
+// calculateDeleteCandidates picks the versions to delete, newest versions first, leaving out the versions
+// referenced by other schemas. Referenced versions count toward the versions to keep unless they are
+// configured to be kept on top of them. References are only looked up for the delete candidates and,
+// when referenced versions are kept on top, for the newest versions until enough versions are kept.
+func (r *schemaVersions) calculateDeleteCandidates(ctx context.Context, model subjectCleanupResourceModel) error {
+	keepReferenced := model.ReferencedVersions.ValueString() == "KEEP"
+
+	r.deleteCandidates = nil
+	r.skippedReferenced = nil
+
+	// Every version is kept anyway
+	if r.schemasToKeep >= len(r.all) {
+		return nil
+	}
+
+	i := len(r.all) - 1
+	for kept := 0; i >= 0 && kept < r.schemasToKeep; i-- {
+		if keepReferenced {
+			referenced, err := r.referenced(ctx, model, r.all[i])
+			if err != nil {
+				return err
+			}
+			if referenced {
+				continue
+			}
+		}
+		kept++
+	}
+
+	for ; i >= 0; i-- {
+		version := r.all[i]
+
+		referenced, err := r.referenced(ctx, model, version)
+		if err != nil {
+			return err
+		}
+
+		if referenced {
+			r.skippedReferenced = append(r.skippedReferenced, version)
+			continue
+		}
+
+		r.deleteCandidates = append(r.deleteCandidates, version)
+	}
+
+	slices.Reverse(r.deleteCandidates)
+	slices.Reverse(r.skippedReferenced)
+	return nil
+}
+
+// referenced reports whether other schemas reference the version. Schema Registry does not look up
+// references of soft deleted versions, they can not be referenced.
+func (r *schemaVersions) referenced(ctx context.Context, model subjectCleanupResourceModel, version int) (bool, error) {
+	if slices.Contains(r.softDeleted, version) {
+		return false, nil
+	}
+
+	referencedBy, err := GetReferencedBy(ctx, r.client, model.subject(), version)
+	if err != nil {
+		return false, err
+	}
+	return len(referencedBy) > 0, nil
+}
+
+// plannedDeletions returns the delete candidates as a list value.
+func (r *schemaVersions) plannedDeletions() (types.List, diag.Diagnostics) {
+	planned := []attr.Value{}
+	for _, version := range r.deleteCandidates {
+		planned = append(planned, types.Int32Value(int32(version)))
+	}
+	return types.ListValue(types.Int32Type, planned)
+}
+
+// restrictToPlanned limits the delete candidates to the versions listed during the plan, so versions that
+// became candidates after the plan are left for the next apply. Unknown planned deletions are not restricted.
+func (r *schemaVersions) restrictToPlanned(ctx context.Context, model subjectCleanupResourceModel) diag.Diagnostics {
+	if model.PlannedDeletions.IsUnknown() || model.PlannedDeletions.IsNull() {
+		return nil
+	}
+
+	var planned []int32
+	diags := model.PlannedDeletions.ElementsAs(ctx, &planned, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	var candidates []int
+	for _, version := range r.deleteCandidates {
+		if !slices.Contains(planned, int32(version)) {
+			tflog.Warn(ctx, fmt.Sprintf("Skipping %s version %v not listed in planned deletions", model.subject(), version))
+			continue
+		}
+		candidates = append(candidates, version)
 	}
+	r.deleteCandidates = candidates
+	return diags
 }
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newCleanupServer serves versions 1 to 5 of a subject, where the given versions are referenced by other schemas.
+// Deletes of referenced versions are rejected the way Schema Registry does. Deletes and reference lookups are recorded.
+func newCleanupServer(t *testing.T, referenced ...int) (*httptest.Server, *[]string, *[]string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var deletes, lookups []string
+
+	isReferenced := func(version string) bool {
+		for _, v := range referenced {
+			if fmt.Sprint(v) == version {
+				return true
+			}
+		}
+		return false
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		path := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+		switch {
+		case r.Method == http.MethodGet && len(path) == 3:
+			fmt.Fprint(w, `[1,2,3,4,5]`)
+		case r.Method == http.MethodGet && len(path) == 5 && path[4] == "referencedby":
+			mu.Lock()
+			lookups = append(lookups, path[3])
+			mu.Unlock()
+			if isReferenced(path[3]) {
+				fmt.Fprint(w, `[100]`)
+				return
+			}
+			fmt.Fprint(w, `[]`)
+		case r.Method == http.MethodDelete && len(path) == 4:
+			if isReferenced(path[3]) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprint(w, `{"error_code":42206,"message":"One or more references exist to the schema"}`)
+				return
+			}
+			mu.Lock()
+			deletes = append(deletes, path[3]+"?"+r.URL.RawQuery)
+			mu.Unlock()
+			fmt.Fprint(w, path[3])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &deletes, &lookups
+}
+
+func TestCalculateDeleteCandidates(t *testing.T) {
+	tests := []struct {
+		name               string
+		referencedVersions string
+		toKeep             int
+		referenced         []int
+		softDeleted        []int
+		candidates         []int
+		skipped            []int
+		lookups            []string
+	}{
+		{name: "no references", referencedVersions: "SKIP", toKeep: 2, candidates: []int{1, 2, 3}, lookups: []string{"3", "2", "1"}},
+		{name: "skip", referencedVersions: "SKIP", toKeep: 2, referenced: []int{2, 4}, candidates: []int{1, 3}, skipped: []int{2}, lookups: []string{"3", "2", "1"}},
+		{name: "keep", referencedVersions: "KEEP", toKeep: 2, referenced: []int{2, 4}, candidates: []int{1}, skipped: []int{2}, lookups: []string{"5", "4", "3", "2", "1"}},
+		{name: "keep latest referenced", referencedVersions: "KEEP", toKeep: 1, referenced: []int{5}, candidates: []int{1, 2, 3}, lookups: []string{"5", "4", "3", "2", "1"}},
+		{name: "keep all", referencedVersions: "KEEP", toKeep: 5, referenced: []int{1}},
+		{name: "keep more than stored", referencedVersions: "KEEP", toKeep: 10},
+		{name: "soft deleted", referencedVersions: "SKIP", toKeep: 2, softDeleted: []int{1, 2}, candidates: []int{1, 2, 3}, lookups: []string{"3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _, lookups := newCleanupServer(t, tt.referenced...)
+
+			r := schemaVersions{
+				client:        newTestClient(t, server.URL),
+				all:           []int{1, 2, 3, 4, 5},
+				softDeleted:   tt.softDeleted,
+				schemasToKeep: tt.toKeep,
+			}
+			model := subjectCleanupResourceModel{
+				SubjectName:        types.StringValue("cleanup"),
+				ReferencedVersions: types.StringValue(tt.referencedVersions),
+			}
+
+			if err := r.calculateDeleteCandidates(t.Context(), model); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(r.deleteCandidates, tt.candidates) {
+				t.Errorf("expected candidates %v, got %v", tt.candidates, r.deleteCandidates)
+			}
+			if !reflect.DeepEqual(r.skippedReferenced, tt.skipped) {
+				t.Errorf("expected skipped %v, got %v", tt.skipped, r.skippedReferenced)
+			}
+			if !reflect.DeepEqual(*lookups, tt.lookups) {
+				t.Errorf("expected reference lookups of versions %v, got %v", tt.lookups, *lookups)
+			}
+		})
+	}
+}
+
+func TestRestrictToPlanned(t *testing.T) {
+	model := subjectCleanupResourceModel{SubjectName: types.StringValue("cleanup")}
+
+	r := schemaVersions{deleteCandidates: []int{1, 2, 3}}
+	model.PlannedDeletions = types.ListUnknown(types.Int32Type)
+	if diags := r.restrictToPlanned(t.Context(), model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !reflect.DeepEqual(r.deleteCandidates, []int{1, 2, 3}) {
+		t.Errorf("expected unknown planned deletions not to restrict candidates, got %v", r.deleteCandidates)
+	}
+
+	// Version 3 became a candidate after the plan, version 0 is no longer one
+	model.PlannedDeletions = types.ListValueMust(types.Int32Type, []attr.Value{
+		types.Int32Value(0), types.Int32Value(1), types.Int32Value(2),
+	})
+	if diags := r.restrictToPlanned(t.Context(), model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !reflect.DeepEqual(r.deleteCandidates, []int{1, 2}) {
+		t.Errorf("expected candidates [1 2], got %v", r.deleteCandidates)
+	}
+}
+
+func TestDeleteSchemaVersionsSkipsReferenced(t *testing.T) {
+	server, deletes, _ := newCleanupServer(t, 2)
+	client := newTestClient(t, server.URL)
+	model := subjectCleanupResourceModel{SubjectName: types.StringValue("cleanup")}
+
+	versions := []int{1, 2, 3}
+	skipped, err := DeleteSchemaVersions(t.Context(), &versions, client, model, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(skipped, []int{2}) {
+		t.Errorf("expected version 2 to be skipped, got %v", skipped)
+	}
+	if !reflect.DeepEqual(versions, []int{1, 3}) {
+		t.Errorf("expected versions 1 and 3 to be deleted, got %v", versions)
+	}
+
+	expected := []string{"1?permanent=false", "1?permanent=true", "3?permanent=false", "3?permanent=true"}
+	if !reflect.DeepEqual(*deletes, expected) {
+		t.Errorf("expected deletes %v, got %v", expected, *deletes)
+	}
+}
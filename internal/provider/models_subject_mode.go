@@ -10,3 +10,12 @@ type SubjectModeRequest struct {
 type SubjectModeResponse struct {
 	Mode string `json:"mode"`
 }
+
+// defaultMode is the global mode of a new Schema Registry.
+const defaultMode = "READWRITE"
+
+// importMode allows registering schemas with explicit ids and versions.
+const importMode = "IMPORT"
+
+// subjectModes are the modes accepted by Schema Registry.
+var subjectModes = []string{"READWRITE", "READONLY", "READONLY_OVERRIDE", "IMPORT"}
@@ -5,14 +5,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -81,6 +86,115 @@ func (p *foxconProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Sensitive:   true,
 				Description: "Confluent Cloud API Key. Can be configured using `SCHEMA_REGISTRY_API_SECRET` environment variable.",
 			},
+			"schema_registry_ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: caCertificateDescription + " Can be configured using `SCHEMA_REGISTRY_CA_CERTIFICATE` environment variable.",
+			},
+			"schema_registry_client_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: clientCertificateDescription + " Can be configured using `SCHEMA_REGISTRY_CLIENT_CERTIFICATE` environment variable.",
+			},
+			"schema_registry_client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: clientKeyDescription + " Can be configured using `SCHEMA_REGISTRY_CLIENT_KEY` environment variable.",
+			},
+			"schema_registry_tls_server_name": schema.StringAttribute{
+				Optional:    true,
+				Description: tlsServerNameDescription + " Can be configured using `SCHEMA_REGISTRY_TLS_SERVER_NAME` environment variable.",
+			},
+			"schema_registries": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Named Schema Registry clusters. Resources, data sources and actions select one of them with the `registry` attribute. Provider level TLS settings apply unless overridden. Named clusters support basic authentication credentials only, `schema_registry_oauth` applies to the provider level cluster only.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rest_endpoint": schema.StringAttribute{
+							Required:    true,
+							Description: restEndpointDescription,
+							Validators: []validator.String{
+								EndpointValidator{},
+							},
+						},
+						"credentials": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Basic authentication credentials of the Schema Registry cluster.",
+							Attributes: map[string]schema.Attribute{
+								"key": schema.StringAttribute{
+									Required:    true,
+									Description: schemaRegistryKeyDescription,
+								},
+								"secret": schema.StringAttribute{
+									Required:    true,
+									Sensitive:   true,
+									Description: schemaRegistrySecretDescription,
+								},
+							},
+						},
+						"tls": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "TLS settings of the Schema Registry connection. Takes precedence over the provider TLS settings.",
+							Attributes: map[string]schema.Attribute{
+								"ca_certificate": schema.StringAttribute{
+									Optional:    true,
+									Description: caCertificateDescription,
+								},
+								"client_certificate": schema.StringAttribute{
+									Optional:    true,
+									Description: clientCertificateDescription,
+								},
+								"client_key": schema.StringAttribute{
+									Optional:    true,
+									Sensitive:   true,
+									Description: clientKeyDescription,
+								},
+								"server_name": schema.StringAttribute{
+									Optional:    true,
+									Description: tlsServerNameDescription,
+								},
+							},
+						},
+					},
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum number of retries for requests failed due to rate limiting (429), unavailable upstream (502, 503, 504) or connection errors. Set to `0` to disable retries. Defaults to: %d", defaultMaxRetries),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retry_wait_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum wait time in seconds between retries. Wait time grows exponentially with jitter unless the server returns a `Retry-After` header. Defaults to: %d", int(defaultRetryWaitMax.Seconds())),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum number of requests per second sent to a single API endpoint. Shared by every resource, data source and action using the same endpoint, including the ones with their own credentials. Set to `0` to disable rate limiting. Defaults to: 0",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"cloud_api_page_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Number of items requested per page from Confluent Cloud list endpoints. Every page is read until the looked up item is found. Defaults to: %d", defaultPageSize),
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(defaultPageSize)),
+				},
+			},
+			"request_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Timeout in seconds of a single API request. Retries of a failed request have their own timeout. Defaults to: %d", int(defaultRequestTimeout.Seconds())),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"schema_registry_oauth": oauthBlock("Schema Registry", true),
+			"cloud_oauth":           oauthBlock("Confluent Cloud API", false),
 		},
 	}
 }
@@ -168,6 +282,52 @@ func (p *foxconProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		schema_registry_rest_endpoint = config.SchemaRegistryEndpoint.ValueString()
 	}
 
+	options := DefaultClientOptions()
+
+	schemaRegistryTLS := TLSOptions{
+		CACertificate:     os.Getenv("SCHEMA_REGISTRY_CA_CERTIFICATE"),
+		ClientCertificate: os.Getenv("SCHEMA_REGISTRY_CLIENT_CERTIFICATE"),
+		ClientKey:         os.Getenv("SCHEMA_REGISTRY_CLIENT_KEY"),
+		ServerName:        os.Getenv("SCHEMA_REGISTRY_TLS_SERVER_NAME"),
+	}
+
+	if !config.SchemaRegistryCACertificate.IsNull() {
+		schemaRegistryTLS.CACertificate = config.SchemaRegistryCACertificate.ValueString()
+	}
+
+	if !config.SchemaRegistryClientCertificate.IsNull() {
+		schemaRegistryTLS.ClientCertificate = config.SchemaRegistryClientCertificate.ValueString()
+	}
+
+	if !config.SchemaRegistryClientKey.IsNull() {
+		schemaRegistryTLS.ClientKey = config.SchemaRegistryClientKey.ValueString()
+	}
+
+	if !config.SchemaRegistryTLSServerName.IsNull() {
+		schemaRegistryTLS.ServerName = config.SchemaRegistryTLSServerName.ValueString()
+	}
+
+	if !config.MaxRetries.IsNull() {
+		options.Retry.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	if !config.MaxRetryWait.IsNull() {
+		options.Retry.WaitMax = time.Duration(config.MaxRetryWait.ValueInt64()) * time.Second
+		options.Retry.WaitMin = min(options.Retry.WaitMin, options.Retry.WaitMax)
+	}
+
+	if !config.RequestTimeout.IsNull() {
+		options.Timeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	if !config.CloudApiPageSize.IsNull() {
+		options.PageSize = int(config.CloudApiPageSize.ValueInt64())
+	}
+
+	if !config.RequestsPerSecond.IsNull() {
+		options.Limiters = NewRateLimiters(config.RequestsPerSecond.ValueFloat64())
+	}
+
 	ctx = tflog.SetField(ctx, "api_endpoint", api_endpoint)
 	ctx = tflog.SetField(ctx, "cloud_api_key", cloud_api_key)
 	ctx = tflog.SetField(ctx, "cloud_api_secret", cloud_api_secret)
@@ -179,12 +339,36 @@ func (p *foxconProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "cloud_api_secret")
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "schema_registry_api_secret")
 
+	// TLS settings only apply to schema registry clients
+	schemaRegistryOptions := options
+	schemaRegistryOptions.TLS = schemaRegistryTLS
+
 	tflog.Debug(ctx, "Creating foxcon client")
 
-	if api_endpoint == "" || cloud_api_key == "" || cloud_api_secret == "" {
+	cloudAuth, err := newAuthenticator(cloud_api_key, cloud_api_secret, config.CloudOAuth, "")
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cloud_oauth"),
+			"Invalid Confluent API authentication",
+			err.Error(),
+		)
+		return
+	}
+
+	schemaRegistryAuth, err := config.SchemaRegistryOAuth.authenticator(schema_registry_api_key, schema_registry_api_secret)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schema_registry_oauth"),
+			"Invalid Schema Registry authentication",
+			err.Error(),
+		)
+		return
+	}
+
+	if api_endpoint == "" || cloudAuth == nil {
 		CloudApiClient = nil
 	} else {
-		CloudApiClient, err = NewClient(&api_endpoint, &cloud_api_key, &cloud_api_secret)
+		CloudApiClient, err = NewClient(&api_endpoint, cloudAuth, options)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Create Confluent API Client",
@@ -196,10 +380,10 @@ func (p *foxconProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
-	if schema_registry_rest_endpoint == "" || schema_registry_api_key == "" || schema_registry_api_secret == "" {
+	if schema_registry_rest_endpoint == "" || schemaRegistryAuth == nil {
 		SchemaRegistryClient = nil
 	} else {
-		SchemaRegistryClient, err = NewClient(&schema_registry_rest_endpoint, &schema_registry_api_key, &schema_registry_api_secret)
+		SchemaRegistryClient, err = NewClient(&schema_registry_rest_endpoint, schemaRegistryAuth, schemaRegistryOptions)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Create Schema API Client",
@@ -211,22 +395,70 @@ func (p *foxconProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
+	schemaRegistries := make(map[string]*Client, len(config.SchemaRegistries))
+	for name, registry := range config.SchemaRegistries {
+		if registry.RestEndpoint.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schema_registries").AtMapKey(name).AtName("rest_endpoint"),
+				"Unknown Schema Registry REST endpoint",
+				fmt.Sprintf("The provider cannot create the '%s' Schema Registry client as there is an unknown configuration value for its REST endpoint.", name),
+			)
+			return
+		}
+
+		if registry.Credentials != nil && (registry.Credentials.Key.IsUnknown() || registry.Credentials.Secret.IsUnknown()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schema_registries").AtMapKey(name).AtName("credentials"),
+				"Unknown Schema Registry credentials",
+				fmt.Sprintf("The provider cannot create the '%s' Schema Registry client as there is an unknown configuration value for its API key or secret.", name),
+			)
+			return
+		}
+
+		var auth Authenticator
+		if registry.Credentials != nil {
+			auth = AuthStruct{
+				Username: registry.Credentials.Key.ValueString(),
+				Password: registry.Credentials.Secret.ValueString(),
+			}
+		}
+
+		registryOptions := schemaRegistryOptions
+		registryOptions.TLS = registry.TLS.options(schemaRegistryOptions.TLS)
+
+		schemaRegistries[name], err = NewClient(registry.RestEndpoint.ValueStringPointer(), auth, registryOptions)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schema_registries").AtMapKey(name),
+				"Unable to Create Schema API Client",
+				fmt.Sprintf("An unexpected error occurred when creating the '%s' Schema API client.\n\nSchema Client Error: %s", name, err.Error()),
+			)
+			return
+		}
+	}
+
 	// Make the client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData =
 		&providerClients{
 			CloudApiClient:       CloudApiClient,
 			SchemaRegistryClient: SchemaRegistryClient,
+			SchemaRegistries:     schemaRegistries,
+			Options:              schemaRegistryOptions,
 		}
 
 	resp.ResourceData = &providerClients{
 		CloudApiClient:       CloudApiClient,
 		SchemaRegistryClient: SchemaRegistryClient,
+		SchemaRegistries:     schemaRegistries,
+		Options:              schemaRegistryOptions,
 	}
 
 	resp.ActionData = &providerClients{
 		CloudApiClient:       CloudApiClient,
 		SchemaRegistryClient: SchemaRegistryClient,
+		SchemaRegistries:     schemaRegistries,
+		Options:              schemaRegistryOptions,
 	}
 
 	tflog.Info(ctx, "Configured foxcon client", map[string]any{"success": true})
@@ -237,6 +469,12 @@ func (p *foxconProvider) DataSources(_ context.Context) []func() datasource.Data
 	return []func() datasource.DataSource{
 		NewSchemaRegistryNormalizationDataSource,
 		NewSubjectVersionsDataSource,
+		NewSubjectModeDataSource,
+		NewSchemaCompatibilityDataSource,
+		NewSubjectsDataSource,
+		NewContextsDataSource,
+		NewSchemaDataSource,
+		NewReferenceGraphDataSource,
 	}
 }
 
@@ -248,12 +486,21 @@ func (p *foxconProvider) Resources(_ context.Context) []func() resource.Resource
 		NewSubjectNormalizationResource,
 		NewSchemaRegistryNormalizationResource,
 		NewSubjectCleanupResource,
+		NewSchemaResource,
+		NewSubjectCompatibilityResource,
+		NewSubjectConfigResource,
+		NewSubjectModeResource,
+		NewSubjectAliasResource,
+		NewSchemaRegistryModeResource,
+		NewExporterResource,
+		NewRuleSetResource,
 	}
 }
 
 func (p *foxconProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		SetSubjectModeAction,
+		SetSchemaRegistryModeAction,
 	}
 }
 
@@ -261,15 +508,31 @@ func (p *foxconProvider) ConfigValidator(ctx context.Context, req provider.Confi
 }
 
 type foxconProviderModel struct {
-	ApiEndpoint            types.String `tfsdk:"api_endpoint"`
-	CloudApiKey            types.String `tfsdk:"cloud_api_key"`
-	CloudApiSecret         types.String `tfsdk:"cloud_api_secret"`
-	SchemaRegistryEndpoint types.String `tfsdk:"schema_registry_rest_endpoint"`
-	SchemaRegistryUsername types.String `tfsdk:"schema_registry_api_key"`
-	SchemaRegistryPassword types.String `tfsdk:"schema_registry_api_secret"`
+	ApiEndpoint                     types.String                   `tfsdk:"api_endpoint"`
+	CloudApiKey                     types.String                   `tfsdk:"cloud_api_key"`
+	CloudApiSecret                  types.String                   `tfsdk:"cloud_api_secret"`
+	SchemaRegistryEndpoint          types.String                   `tfsdk:"schema_registry_rest_endpoint"`
+	SchemaRegistryUsername          types.String                   `tfsdk:"schema_registry_api_key"`
+	SchemaRegistryPassword          types.String                   `tfsdk:"schema_registry_api_secret"`
+	SchemaRegistryCACertificate     types.String                   `tfsdk:"schema_registry_ca_certificate"`
+	SchemaRegistryClientCertificate types.String                   `tfsdk:"schema_registry_client_certificate"`
+	SchemaRegistryClientKey         types.String                   `tfsdk:"schema_registry_client_key"`
+	SchemaRegistryTLSServerName     types.String                   `tfsdk:"schema_registry_tls_server_name"`
+	SchemaRegistryOAuth             *schemaRegistryOAuthModel      `tfsdk:"schema_registry_oauth"`
+	CloudOAuth                      *oauthModel                    `tfsdk:"cloud_oauth"`
+	MaxRetries                      types.Int64                    `tfsdk:"max_retries"`
+	MaxRetryWait                    types.Int64                    `tfsdk:"max_retry_wait_seconds"`
+	RequestTimeout                  types.Int64                    `tfsdk:"request_timeout_seconds"`
+	RequestsPerSecond               types.Float64                  `tfsdk:"requests_per_second"`
+	CloudApiPageSize                types.Int64                    `tfsdk:"cloud_api_page_size"`
+	SchemaRegistries                map[string]schemaRegistryModel `tfsdk:"schema_registries"`
 }
 
 type providerClients struct {
 	CloudApiClient       *Client
 	SchemaRegistryClient *Client
+	// SchemaRegistries are the named registries selected with the `registry` attribute
+	SchemaRegistries map[string]*Client
+	// Options are applied to the schema registry clients created on a resource level
+	Options ClientOptions
 }
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -63,6 +64,23 @@ provider "foxcon" {
 }
 `
 
+var namedRegistriesProviderConfig = `
+provider "foxcon" {
+  schema_registries = {
+    local = {
+      rest_endpoint = "` + rest_endpoint + `"
+      credentials = {
+        key = "` + api_key + `"
+        secret = "` + api_secret + `"
+      }
+    }
+    unreachable = {
+      rest_endpoint = "http://1.1.1.1"
+    }
+  }
+}
+`
+
 type Payload struct {
 	Schema     string `json:"schema"`
 	SchemaType string `json:"schemaType"`
@@ -104,6 +122,32 @@ func validateSubjectVersions(subject string, expectedResponse string) error {
 	return nil
 }
 
+// validateSubjectConfigFields compares the fields set in the subject config. Compatibility level is
+// ignored as Schema Registry may return the inherited one.
+func validateSubjectConfigFields(subject string, expected []string) error {
+	strbody, respCode, err := callSchemaRegistry("GET", fmt.Sprintf("%s/config/%s", rest_endpoint, subject), nil)
+
+	var attrs []string
+	if respCode != http.StatusNotFound {
+		if err != nil {
+			return err
+		}
+
+		var config SchemaConfigResponse
+		if err = json.Unmarshal([]byte(strbody), &config); err != nil {
+			return err
+		}
+		config.CompatibilityLevel = nil
+		attrs = parseResponseAttrs(&config)
+	}
+
+	sort.Strings(expected)
+	if strings.Join(attrs, ",") != strings.Join(expected, ",") {
+		return fmt.Errorf("unexpected subject config fields: got %v, want %v", attrs, expected)
+	}
+	return nil
+}
+
 func removeSubjectVersions(subject string, schemasToRemove []int) error {
 
 	for _, i := range schemasToRemove {
@@ -116,7 +160,8 @@ func removeSubjectVersions(subject string, schemasToRemove []int) error {
 	return nil
 }
 
-func addSubjectVersions(subject string, schemasToAdd []int) error {
+// readSchemaFixture returns the content of the tests/schemas/v<version>.json fixture.
+func readSchemaFixture(version int) (string, error) {
 	schemasLocation := "tests/schemas"
 
 	gitRootCmd := exec.Command("git", "rev-parse", "--show-toplevel")
@@ -126,20 +171,28 @@ func addSubjectVersions(subject string, schemasToAdd []int) error {
 	}
 	gitRoot := strings.TrimSpace(string(output))
 
+	data, err := os.ReadFile(fmt.Sprintf("%s/%s/v%d.json", gitRoot, schemasLocation, version))
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %s", err)
+	}
+	return string(data), nil
+}
+
+func addSubjectVersions(subject string, schemasToAdd []int) error {
 	jsonPayload := []byte(`{"compatibility": "NONE"}`)
-	_, _, err = callSchemaRegistry("PUT", fmt.Sprintf("%s/config/%s", rest_endpoint, subject), bytes.NewBuffer(jsonPayload))
+	_, _, err := callSchemaRegistry("PUT", fmt.Sprintf("%s/config/%s", rest_endpoint, subject), bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return err
 	}
 
 	for _, i := range schemasToAdd {
-		data, err := os.ReadFile(fmt.Sprintf("%s/%s/v%d.json", gitRoot, schemasLocation, i))
+		data, err := readSchemaFixture(i)
 		if err != nil {
-			return fmt.Errorf("failed to open file: %s", err)
+			return err
 		}
 
 		payload := Payload{
-			Schema:     string(data),
+			Schema:     data,
 			SchemaType: "JSON",
 		}
 
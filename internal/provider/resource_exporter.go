@@ -0,0 +1,735 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &exporterResource{}
+	_ resource.ResourceWithConfigure      = &exporterResource{}
+	_ resource.ResourceWithValidateConfig = &exporterResource{}
+	_ resource.ResourceWithImportState    = &exporterResource{}
+)
+
+// NewExporterResource is a helper function to simplify the provider implementation.
+func NewExporterResource() resource.Resource {
+	return &exporterResource{}
+}
+
+// exporterResource is the resource implementation.
+type exporterResource struct {
+	clients *providerClients
+}
+
+// Metadata returns the resource type name.
+func (r *exporterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exporter"
+}
+
+// Schema defines the schema for the resource.
+func (r *exporterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a schema exporter, which copies schemas of the selected subjects to a destination Schema Registry. The exporter is paused before its definition is updated or its offset is reset.",
+		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the exporter.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subjects": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The subjects to export. Conflicts with `subject_prefix`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"subject_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Export every subject starting with the prefix. Conflicts with `subjects`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("subjects"),
+					),
+				},
+			},
+			"context_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("AUTO"),
+				Description: "Context of the exported subjects in the destination registry. `AUTO` uses a context named after the source registry, `CUSTOM` the `context_name` and `NONE` the default context. Defaults to `AUTO`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(exporterContextTypes...),
+				},
+			},
+			"context_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Destination context of the exported subjects. Required when `context_type` is `CUSTOM`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"subject_rename_format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Format of the exported subject names, `${subject}` is replaced with the source subject name. For example `dc1.${subject}`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`\$\{subject\}`), "must contain ${subject}"),
+				},
+			},
+			"paused": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Pause the exporter. Defaults to `false`.",
+			},
+			"reset_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value, changing it resets the exporter offset so every schema is exported again.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the exporter, for example `RUNNING`, `PAUSED` or `ERROR`.",
+			},
+			"offset": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The offset of the last exported schema.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"destination": schema.SingleNestedBlock{
+				Description: "The destination Schema Registry.",
+				Attributes: map[string]schema.Attribute{
+					"rest_endpoint": schema.StringAttribute{
+						Optional:    true,
+						Description: "The REST endpoint of the destination Schema Registry cluster.",
+						Validators: []validator.String{
+							EndpointValidator{},
+						},
+					},
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: "The API Key of the destination Schema Registry.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("destination").AtName("secret"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Description: "The API Secret of the destination Schema Registry. The secret is write-only, it is neither stored in the state nor read back from Schema Registry. Requires Terraform 1.11 or later.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("destination").AtName("key"),
+							),
+						},
+					},
+					"secret_version": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Version of the secret. Change it to send an updated secret, changes of the write-only secret itself are not detected.",
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+			},
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type exporterDestinationModel struct {
+	RestEndpoint  types.String `tfsdk:"rest_endpoint"`
+	Key           types.String `tfsdk:"key"`
+	Secret        types.String `tfsdk:"secret"`
+	SecretVersion types.Int64  `tfsdk:"secret_version"`
+}
+
+type exporterResourceModel struct {
+	RestEndpoint        types.String              `tfsdk:"rest_endpoint"`
+	Registry            types.String              `tfsdk:"registry"`
+	Name                types.String              `tfsdk:"name"`
+	Subjects            types.List                `tfsdk:"subjects"`
+	SubjectPrefix       types.String              `tfsdk:"subject_prefix"`
+	ContextType         types.String              `tfsdk:"context_type"`
+	ContextName         types.String              `tfsdk:"context_name"`
+	SubjectRenameFormat types.String              `tfsdk:"subject_rename_format"`
+	Paused              types.Bool                `tfsdk:"paused"`
+	ResetTrigger        types.String              `tfsdk:"reset_trigger"`
+	Status              types.String              `tfsdk:"status"`
+	Offset              types.Int64               `tfsdk:"offset"`
+	Destination         *exporterDestinationModel `tfsdk:"destination"`
+	Credentials         *credentialsModel         `tfsdk:"credentials"`
+	TLS                 *tlsModel                 `tfsdk:"tls"`
+	Timeouts            timeouts.Value            `tfsdk:"timeouts"`
+}
+
+func (m *exporterResourceModel) client(clients *providerClients) (*Client, error) {
+	creds := schemaRegistryCredentials{
+		RestEndpoint: m.RestEndpoint,
+		Registry:     m.Registry,
+		Credentials:  m.Credentials,
+		TLS:          m.TLS,
+	}
+
+	return schemaRegistryClientFactory(clients, &creds)
+}
+
+// request builds the exporter definition. Subject prefix is sent as a wildcard subject.
+func (m *exporterResourceModel) request(ctx context.Context) (ExporterRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := ExporterRequest{
+		Name:                m.Name.ValueString(),
+		ContextType:         m.ContextType.ValueString(),
+		SubjectRenameFormat: m.SubjectRenameFormat.ValueString(),
+		Config:              map[string]string{},
+	}
+
+	if m.SubjectPrefix.IsNull() {
+		diags.Append(m.Subjects.ElementsAs(ctx, &payload.Subjects, false)...)
+	} else {
+		payload.Subjects = []string{m.SubjectPrefix.ValueString() + "*"}
+	}
+
+	if payload.ContextType == exporterContextCustom {
+		payload.Context = m.ContextName.ValueString()
+	}
+
+	if m.Destination != nil {
+		payload.Config[exporterURLConfig] = m.Destination.RestEndpoint.ValueString()
+		if !m.Destination.Key.IsNull() {
+			payload.Config[exporterCredentialsSourceConfig] = "USER_INFO"
+			payload.Config[exporterUserInfoConfig] = m.Destination.Key.ValueString() + ":" + m.Destination.Secret.ValueString()
+		}
+	}
+
+	return payload, diags
+}
+
+// readSecret sets the write-only destination secret, which is only available in the configuration.
+func (m *exporterResourceModel) readSecret(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	if m.Destination == nil {
+		return nil
+	}
+	return config.GetAttribute(ctx, path.Root("destination").AtName("secret"), &m.Destination.Secret)
+}
+
+// refresh sets the exporter definition returned by Schema Registry.
+func (m *exporterResourceModel) refresh(ctx context.Context, exporter *ExporterResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Prefix is the only wildcard subject, configured subjects are kept as they are
+	if len(exporter.Subjects) == 1 && strings.HasSuffix(exporter.Subjects[0], "*") && m.Subjects.IsNull() {
+		m.SubjectPrefix = types.StringValue(strings.TrimSuffix(exporter.Subjects[0], "*"))
+	} else {
+		m.SubjectPrefix = types.StringNull()
+		m.Subjects, diags = types.ListValueFrom(ctx, types.StringType, exporter.Subjects)
+	}
+
+	if exporter.ContextType != "" {
+		m.ContextType = types.StringValue(exporter.ContextType)
+	}
+
+	if exporter.ContextType == exporterContextCustom {
+		m.ContextName = types.StringValue(exporter.Context)
+	} else {
+		m.ContextName = types.StringNull()
+	}
+
+	if exporter.SubjectRenameFormat != "" {
+		m.SubjectRenameFormat = types.StringValue(exporter.SubjectRenameFormat)
+	} else {
+		m.SubjectRenameFormat = types.StringNull()
+	}
+
+	if m.Destination == nil {
+		// Imported exporter, the secret is write-only and never read back
+		m.Destination = &exporterDestinationModel{
+			Secret:        types.StringNull(),
+			SecretVersion: types.Int64Null(),
+		}
+	}
+	m.Destination.RestEndpoint = types.StringValue(exporter.Config[exporterURLConfig])
+
+	// Key is taken from the exporter config, so a key changed outside of Terraform is detected
+	m.Destination.Key = types.StringNull()
+	if key, _, found := strings.Cut(exporter.Config[exporterUserInfoConfig], ":"); found {
+		m.Destination.Key = types.StringValue(key)
+	}
+
+	return diags
+}
+
+// readStatus sets the computed state and offset of the exporter.
+func (m *exporterResourceModel) readStatus(ctx context.Context, client *Client) error {
+	status, err := GetExporterStatus(ctx, client, m.Name.ValueString())
+	if err != nil {
+		return err
+	}
+
+	m.Status = types.StringValue(status.State)
+	m.Offset = types.Int64Value(status.Offset)
+
+	return nil
+}
+
+func (r *exporterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config exporterResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateResourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ContextType.IsUnknown() || config.ContextName.IsUnknown() {
+		return
+	}
+
+	custom := config.ContextType.ValueString() == exporterContextCustom
+
+	if custom && config.ContextName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("context_name"),
+			"Missing context name",
+			"context_name must be set when context_type is CUSTOM.",
+		)
+	}
+
+	if !custom && !config.ContextName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("context_name"),
+			"Invalid context name",
+			"context_name can only be set when context_type is CUSTOM.",
+		)
+	}
+
+	if config.Destination != nil && config.Destination.RestEndpoint.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destination").AtName("rest_endpoint"),
+			"Missing destination endpoint",
+			"rest_endpoint of the destination Schema Registry must be set.",
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *exporterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan exporterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	schemaAPIClient, err := plan.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.readSecret(ctx, req.Config)...)
+	payload, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = CreateExporter(ctx, schemaAPIClient, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating exporter",
+			"Could not create exporter "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Exporter starts right away
+	if plan.Paused.ValueBool() {
+		err = PauseExporter(ctx, schemaAPIClient, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error pausing exporter",
+				"Could not pause exporter "+plan.Name.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err = plan.readStatus(ctx, schemaAPIClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading exporter status",
+			"Could not read status of exporter "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *exporterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state exporterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	exporter, err := GetExporter(ctx, schemaAPIClient, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading exporter",
+			"Could not read exporter "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Exporter was deleted outside of Terraform
+	if exporter == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refresh(ctx, exporter)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = state.readStatus(ctx, schemaAPIClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading exporter status",
+			"Could not read status of exporter "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Paused = types.BoolValue(state.Status.ValueString() == exporterStatePaused)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update pauses the exporter before its definition is replaced or its offset is reset, then resumes it unless paused is set.
+func (r *exporterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state exporterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	schemaAPIClient, err := plan.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	payload, diags := plan.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	current, diags := state.request(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	// Secret is write-only, a new one is sent along with a new secret version
+	changed := !reflect.DeepEqual(payload, current) ||
+		(plan.Destination != nil && state.Destination != nil && !plan.Destination.SecretVersion.Equal(state.Destination.SecretVersion))
+	reset := !plan.ResetTrigger.IsNull() && !plan.ResetTrigger.Equal(state.ResetTrigger)
+	paused := state.Paused.ValueBool()
+
+	command := func(summary string, f func(context.Context, *Client, string) error) bool {
+		if err := f(ctx, schemaAPIClient, name); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating exporter",
+				"Could not "+summary+" exporter "+name+": "+err.Error(),
+			)
+			return false
+		}
+		return true
+	}
+
+	if (changed || reset) && !paused {
+		tflog.Debug(ctx, fmt.Sprintf("Pausing exporter %s before update", name))
+		if !command("pause", PauseExporter) {
+			return
+		}
+		paused = true
+	}
+
+	if changed {
+		resp.Diagnostics.Append(plan.readSecret(ctx, req.Config)...)
+		payload, diags = plan.request(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err = UpdateExporter(ctx, schemaAPIClient, name, payload)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating exporter",
+				"Could not update exporter "+name+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if reset && !command("reset", ResetExporter) {
+		return
+	}
+
+	if plan.Paused.ValueBool() && !paused && !command("pause", PauseExporter) {
+		return
+	}
+
+	if !plan.Paused.ValueBool() && paused && !command("resume", ResumeExporter) {
+		return
+	}
+
+	err = plan.readStatus(ctx, schemaAPIClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading exporter status",
+			"Could not read status of exporter "+name+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete pauses and deletes the exporter. Exported schemas are kept in the destination registry.
+func (r *exporterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state exporterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !state.Paused.ValueBool() {
+		err = PauseExporter(ctx, schemaAPIClient, state.Name.ValueString())
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			resp.Diagnostics.AddError(
+				"Error deleting exporter",
+				"Could not pause exporter "+state.Name.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting exporter %s", state.Name.ValueString()))
+	err = DeleteExporter(ctx, schemaAPIClient, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting exporter",
+			"Could not delete exporter: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *exporterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.clients = clients
+}
+
+// ImportState imports an exporter. Import ID is the exporter name.
+func (r *exporterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	importSchemaRegistryCredentials(ctx, resp)
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func exporterResourceConfig(endpoint string, attributes string) string {
+	return `
+provider "foxcon" {
+  schema_registry_rest_endpoint = "` + endpoint + `"
+  schema_registry_api_key = "` + api_key + `"
+  schema_registry_api_secret = "` + api_secret + `"
+}
+
+resource "foxcon_exporter" "test" {
+  name = "dc2"
+` + attributes + `
+  destination {
+    rest_endpoint = "http://dc2:8081"
+    key = "dc2-key"
+    secret = "dc2-secret"
+  }
+}
+`
+}
+
+func TestExporterResource(t *testing.T) {
+	// Exporters need a second registry, the resource is tested against a fake exporter endpoint
+	endpoint := newExporterServer(t).URL
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Destination secret is write-only
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: exporterResourceConfig(endpoint, `
+  subject_prefix = "orders"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "subject_prefix", "orders"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "context_type", "AUTO"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "paused", "false"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "status", "RUNNING"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "offset", "1"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "destination.key", "dc2-key"),
+					resource.TestCheckNoResourceAttr("foxcon_exporter.test", "destination.secret"),
+				),
+			},
+			// Update pauses the exporter, replaces the definition and resets the offset
+			{
+				Config: exporterResourceConfig(endpoint, `
+  subjects = ["orders", "payments"]
+  context_type = "CUSTOM"
+  context_name = ".dc1"
+  subject_rename_format = "dc1.$${subject}"
+  reset_trigger = "1"
+  paused = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "subjects.#", "2"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "context_name", ".dc1"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "subject_rename_format", "dc1.${subject}"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "status", "PAUSED"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "offset", "0"),
+				),
+			},
+			// Resume testing
+			{
+				Config: exporterResourceConfig(endpoint, `
+  subjects = ["orders", "payments"]
+  context_type = "CUSTOM"
+  context_name = ".dc1"
+  subject_rename_format = "dc1.$${subject}"
+  reset_trigger = "1"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "paused", "false"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "status", "RUNNING"),
+					resource.TestCheckResourceAttr("foxcon_exporter.test", "offset", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "foxcon_exporter.test",
+				ImportState:                          true,
+				ImportStateId:                        "dc2",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"reset_trigger"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestExporterResourceCustomContextRequiresName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Destination secret is write-only
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: exporterResourceConfig(rest_endpoint, `
+  subject_prefix = "orders"
+  context_type = "CUSTOM"
+`),
+				ExpectError: regexp.MustCompile(`context_name\s+must\s+be\s+set`),
+			},
+		},
+	})
+}
+
+func TestExporterRefreshDestinationKey(t *testing.T) {
+	model := exporterResourceModel{
+		Subjects: types.ListNull(types.StringType),
+		Destination: &exporterDestinationModel{
+			RestEndpoint:  types.StringValue("http://dc2:8081"),
+			Key:           types.StringValue("dc2-key"),
+			Secret:        types.StringNull(),
+			SecretVersion: types.Int64Value(1),
+		},
+	}
+
+	// Key changed outside of Terraform
+	exporter := ExporterResponse{
+		Name:     "dc2",
+		Subjects: []string{"orders*"},
+		Config: map[string]string{
+			exporterURLConfig:      "http://dc2:8081",
+			exporterUserInfoConfig: "rotated-key:rotated-secret",
+		},
+	}
+
+	if diags := model.refresh(t.Context(), &exporter); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if model.Destination.Key.ValueString() != "rotated-key" {
+		t.Errorf("expected refreshed destination key, got %s", model.Destination.Key)
+	}
+	if !model.Destination.Secret.IsNull() || model.Destination.SecretVersion.ValueInt64() != 1 {
+		t.Errorf("expected secret to stay out of state, got %s version %s", model.Destination.Secret, model.Destination.SecretVersion)
+	}
+}
@@ -92,7 +92,7 @@ func (r *invitationResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Create Invitation
-	invitation, err := r.client.CreateInvitation(invitationPayload)
+	invitation, err := r.client.CreateInvitation(ctx, invitationPayload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating invitation",
@@ -130,7 +130,7 @@ func (r *invitationResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if !state.InvitationId.IsNull() {
-		invitation, err = r.client.GetUserInvitationById(state.InvitationId.ValueString())
+		invitation, err = r.client.GetUserInvitationById(ctx, state.InvitationId.ValueString())
 	}
 
 	if err != nil {
@@ -142,7 +142,7 @@ func (r *invitationResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if !state.Email.IsNull() {
-		invitation, err = r.client.GetUserInvitationByParameter("email", state.Email.ValueString())
+		invitation, err = r.client.GetUserInvitationByParameter(ctx, "email", state.Email.ValueString())
 	}
 
 	if err != nil {
@@ -185,7 +185,7 @@ func (r *invitationResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Get User Invitation
-	invitation, err := r.client.GetUserInvitationById(plan.InvitationId.ValueString())
+	invitation, err := r.client.GetUserInvitationById(ctx, plan.InvitationId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading invitation",
@@ -218,7 +218,7 @@ func (r *invitationResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Delete existing user
-	err := r.client.DeleteUser(state.UserId.ValueString())
+	err := r.client.DeleteUser(ctx, state.UserId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Confluent User",
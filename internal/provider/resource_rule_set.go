@@ -0,0 +1,753 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &ruleSetResource{}
+	_ resource.ResourceWithConfigure      = &ruleSetResource{}
+	_ resource.ResourceWithValidateConfig = &ruleSetResource{}
+	_ resource.ResourceWithModifyPlan     = &ruleSetResource{}
+	_ resource.ResourceWithImportState    = &ruleSetResource{}
+)
+
+// ruleActionRegex matches a rule action, or an action per direction of two-way modes.
+var ruleActionRegex = regexp.MustCompile(`^(NONE|ERROR|DLQ)(,(NONE|ERROR|DLQ))?$`)
+
+// NewRuleSetResource is a helper function to simplify the provider implementation.
+func NewRuleSetResource() resource.Resource {
+	return &ruleSetResource{}
+}
+
+// ruleSetResource is the resource implementation.
+type ruleSetResource struct {
+	clients *providerClients
+}
+
+// Metadata returns the resource type name.
+func (r *ruleSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule_set"
+}
+
+func ruleBlock(description string, modes []string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:    true,
+					Description: "The name of the rule, unique within the rule set.",
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"kind": schema.StringAttribute{
+					Required:    true,
+					Description: "The kind of the rule. Accepted values are: `TRANSFORM` and `CONDITION`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(ruleKinds...),
+					},
+				},
+				"mode": schema.StringAttribute{
+					Required:    true,
+					Description: "When the rule applies. Accepted values are: `" + strings.Join(modes, "`, `") + "`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(modes...),
+					},
+				},
+				"type": schema.StringAttribute{
+					Required:    true,
+					Description: "The rule executor, for example `CEL`, `CEL_FIELD`, `JSONATA` or `ENCRYPT`.",
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"expr": schema.StringAttribute{
+					Optional:    true,
+					Description: "The rule expression. Required for `CEL`, `CEL_FIELD` and `JSONATA` rules.",
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"params": schema.MapAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "Parameters of the rule executor.",
+					Validators: []validator.Map{
+						mapvalidator.SizeAtLeast(1),
+					},
+				},
+				"on_success": schema.StringAttribute{
+					Optional:    true,
+					Description: ruleActionDescription("success"),
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(ruleActionRegex, "must be NONE, ERROR or DLQ, or a comma separated pair of them"),
+					},
+				},
+				"on_failure": schema.StringAttribute{
+					Optional:    true,
+					Description: ruleActionDescription("failure"),
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(ruleActionRegex, "must be NONE, ERROR or DLQ, or a comma separated pair of them"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func ruleActionDescription(outcome string) string {
+	return "Action on rule " + outcome + ". Accepted values are: `NONE`, `ERROR` and `DLQ`. Two-way modes accept an action per direction, for example `NONE,ERROR`."
+}
+
+// Schema defines the schema for the resource.
+func (r *ruleSetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the default or override rule set of a subject, a context or the whole registry. Destroying the resource removes the rule set of a subject, other config fields are kept. Context and registry rule sets are left in place.",
+		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"subject_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the subject. The rule set applies to the context or the whole registry when omitted.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context": resourceContextAttribute(),
+			"override": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Set the override rule set, which replaces the rule set of registered schemas. Otherwise the default rule set is set, which is used for schemas registered without a rule set. Defaults to `false`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"domain_rule":    ruleBlock("Rules applied when a schema is written or read, in the order they are applied.", domainRuleModes),
+			"migration_rule": ruleBlock("Rules applied when data is migrated between schema versions, in the order they are applied.", migrationRuleModes),
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type ruleSetResourceModel struct {
+	RestEndpoint   types.String      `tfsdk:"rest_endpoint"`
+	Registry       types.String      `tfsdk:"registry"`
+	SubjectName    types.String      `tfsdk:"subject_name"`
+	Context        types.String      `tfsdk:"context"`
+	Override       types.Bool        `tfsdk:"override"`
+	DomainRules    []ruleModel       `tfsdk:"domain_rule"`
+	MigrationRules []ruleModel       `tfsdk:"migration_rule"`
+	Credentials    *credentialsModel `tfsdk:"credentials"`
+	TLS            *tlsModel         `tfsdk:"tls"`
+	Timeouts       timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// subject returns the subject name qualified with its context. Context and registry level
+// rule sets are set on the context config.
+func (m *ruleSetResourceModel) subject() string {
+	if m.SubjectName.IsNull() {
+		return qualifiedSubject(m.Context, types.StringValue(""))
+	}
+	return qualifiedSubject(m.Context, m.SubjectName)
+}
+
+// shared reports whether the rule set is set on a context or registry config, which every subject inherits.
+// Schema Registry can only remove fields of such a config by deleting it.
+func (m *ruleSetResourceModel) shared() bool {
+	return m.SubjectName.IsNull()
+}
+
+// field returns the managed config field named as returned by parseResponseAttrs.
+func (m *ruleSetResourceModel) field() string {
+	if m.Override.ValueBool() {
+		return "overrideRuleSet"
+	}
+	return "defaultRuleSet"
+}
+
+func (m *ruleSetResourceModel) client(clients *providerClients) (*Client, error) {
+	creds := schemaRegistryCredentials{
+		RestEndpoint: m.RestEndpoint,
+		Registry:     m.Registry,
+		Credentials:  m.Credentials,
+		TLS:          m.TLS,
+	}
+
+	return schemaRegistryClientFactory(clients, &creds)
+}
+
+func (m *ruleSetResourceModel) ruleSet(ctx context.Context) (RuleSet, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	var ruleSet RuleSet
+
+	ruleSet.DomainRules, d = rulesFromModels(ctx, m.DomainRules)
+	diags.Append(d...)
+	ruleSet.MigrationRules, d = rulesFromModels(ctx, m.MigrationRules)
+	diags.Append(d...)
+
+	return ruleSet, diags
+}
+
+// current returns the rule set of the config field, nil when it is not set.
+func (m *ruleSetResourceModel) current(config *SchemaConfigResponse) (*RuleSet, error) {
+	if config == nil {
+		return nil, nil
+	}
+	if m.Override.ValueBool() {
+		return parseRuleSet(config.OverrideRuleSet)
+	}
+	return parseRuleSet(config.DefaultRuleSet)
+}
+
+// validateRules checks the rules of a block. Names must be unique within the rule set.
+func validateRules(block string, rules []ruleModel, names map[string]bool, diags *diag.Diagnostics) {
+	for i, rule := range rules {
+		rulePath := path.Root(block).AtListIndex(i)
+
+		if !rule.Name.IsUnknown() && !rule.Name.IsNull() {
+			if names[rule.Name.ValueString()] {
+				diags.AddAttributeError(
+					rulePath.AtName("name"),
+					"Duplicate rule name",
+					fmt.Sprintf("Rule '%s' is defined more than once, rule names must be unique within the rule set.", rule.Name.ValueString()),
+				)
+			}
+			names[rule.Name.ValueString()] = true
+		}
+
+		if !rule.Type.IsUnknown() && slices.Contains(ruleExpressionTypes, rule.Type.ValueString()) && rule.Expr.IsNull() {
+			diags.AddAttributeError(
+				rulePath.AtName("expr"),
+				"Missing rule expression",
+				fmt.Sprintf("expr must be set for %s rules.", rule.Type.ValueString()),
+			)
+		}
+
+		if rule.Mode.IsUnknown() || slices.Contains(ruleTwoWayModes, rule.Mode.ValueString()) {
+			continue
+		}
+
+		for name, action := range map[string]types.String{"on_success": rule.OnSuccess, "on_failure": rule.OnFailure} {
+			if !action.IsUnknown() && strings.Contains(action.ValueString(), ",") {
+				diags.AddAttributeError(
+					rulePath.AtName(name),
+					"Invalid rule action",
+					fmt.Sprintf("An action per direction can only be set for %s rules.", strings.Join(ruleTwoWayModes, " and ")),
+				)
+			}
+		}
+	}
+}
+
+func (r *ruleSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ruleSetResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateResourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(config.DomainRules) == 0 && len(config.MigrationRules) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing rules",
+			"At least one 'domain_rule' or 'migration_rule' block must be set.",
+		)
+		return
+	}
+
+	names := map[string]bool{}
+	validateRules("domain_rule", config.DomainRules, names, &resp.Diagnostics)
+	validateRules("migration_rule", config.MigrationRules, names, &resp.Diagnostics)
+}
+
+// ModifyPlan refuses changes of a context or registry rule set that can not be merged into the current one,
+// removing the current rule set would delete the config every subject inherits.
+func (r *ruleSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ruleSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SubjectName.IsUnknown() || !plan.shared() || plan.Context.IsUnknown() || plan.Override.IsUnknown() ||
+		plan.RestEndpoint.IsUnknown() || plan.Registry.IsUnknown() {
+		return
+	}
+
+	if plan.Credentials != nil && (plan.Credentials.Key.IsUnknown() || plan.Credentials.Secret.IsUnknown()) {
+		return
+	}
+
+	if plan.TLS != nil && (plan.TLS.CACertificate.IsUnknown() || plan.TLS.ClientCertificate.IsUnknown() ||
+		plan.TLS.ClientKey.IsUnknown() || plan.TLS.ServerName.IsUnknown()) {
+		return
+	}
+
+	// Only rule names decide whether the rule set can be merged
+	var ruleSet RuleSet
+	for _, rule := range plan.DomainRules {
+		if rule.Name.IsUnknown() {
+			return
+		}
+		ruleSet.DomainRules = append(ruleSet.DomainRules, Rule{Name: rule.Name.ValueString()})
+	}
+	for _, rule := range plan.MigrationRules {
+		if rule.Name.IsUnknown() {
+			return
+		}
+		ruleSet.MigrationRules = append(ruleSet.MigrationRules, Rule{Name: rule.Name.ValueString()})
+	}
+
+	schemaAPIClient, err := plan.client(r.clients)
+	if err != nil {
+		// Connection is validated on apply
+		tflog.Debug(ctx, "Skipping rule set merge check: "+err.Error())
+		return
+	}
+
+	subject := plan.subject()
+
+	config, err := GetSubjectConfig(ctx, schemaAPIClient, subject)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading rule set",
+			"Could not read config of "+subjectLabel(subject)+": "+err.Error(),
+		)
+		return
+	}
+
+	current, err := plan.current(config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading rule set",
+			"Could not decode rule set of "+subjectLabel(subject)+": "+err.Error(),
+		)
+		return
+	}
+
+	if ruleSet.replacesRules(current) {
+		resp.Diagnostics.AddError(
+			"Rule set can not be merged",
+			fmt.Sprintf("Current %s of %s has rules %v. Rules of a context or registry rule set can only be added at the end, "+
+				"removing or reordering them would delete the config every subject inherits.", plan.field(), subjectLabel(subject), current.names()),
+		)
+	}
+}
+
+// apply sets the planned rule set. Rule sets that can not be merged into the current one are removed first.
+func (r *ruleSetResource) apply(ctx context.Context, plan *ruleSetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaAPIClient, err := plan.client(r.clients)
+	if err != nil {
+		diags.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	subject := plan.subject()
+
+	ruleSet, d := plan.ruleSet(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	config, err := GetSubjectConfig(ctx, schemaAPIClient, subject)
+	if err != nil {
+		diags.AddError(
+			"Error reading rule set",
+			"Could not read config of "+subjectLabel(subject)+": "+err.Error(),
+		)
+		return diags
+	}
+
+	current, err := plan.current(config)
+	if err != nil {
+		diags.AddError(
+			"Error reading rule set",
+			"Could not decode rule set of "+subjectLabel(subject)+": "+err.Error(),
+		)
+		return diags
+	}
+
+	if ruleSet.replacesRules(current) && plan.shared() {
+		// Rule set changed since the plan
+		diags.AddError(
+			"Rule set can not be merged",
+			fmt.Sprintf("Current %s of %s has rules %v, it can not be replaced without deleting the config every subject inherits.",
+				plan.field(), subjectLabel(subject), current.names()),
+		)
+		return diags
+	}
+
+	if ruleSet.replacesRules(current) {
+		tflog.Debug(ctx, fmt.Sprintf("Removing %s of %s before it is set again", plan.field(), subjectLabel(subject)))
+
+		// Compatibility level is kept as it is, it may be managed by foxcon_subject_compatibility
+		err = ClearSubjectConfigFields(ctx, schemaAPIClient, subject, []string{plan.field()}, []string{"compatibilityLevel"})
+		if err != nil {
+			diags.AddError(
+				"Error setting rule set",
+				"Could not remove current rule set of "+subjectLabel(subject)+": "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	raw, err := ruleSet.raw()
+	if err != nil {
+		diags.AddError(
+			"Error setting rule set",
+			"Could not encode rule set: "+err.Error(),
+		)
+		return diags
+	}
+
+	var request SubjectConfigRequest
+	if plan.Override.ValueBool() {
+		request.OverrideRuleSet = raw
+	} else {
+		request.DefaultRuleSet = raw
+	}
+
+	err = UpdateSubjectConfig(ctx, schemaAPIClient, subject, request)
+	if err != nil {
+		diags.AddError(
+			"Error setting rule set",
+			"Could not set rule set of "+subjectLabel(subject)+": "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// subjectLabel names the config owner in messages.
+func subjectLabel(subject string) string {
+	if subject == "" {
+		return "the registry"
+	}
+	return "subject " + subject
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ruleSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ruleSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ruleSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ruleSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	subject := state.subject()
+
+	config, err := GetSubjectConfig(ctx, schemaAPIClient, subject)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading rule set",
+			"Could not read config of "+subjectLabel(subject)+": "+err.Error(),
+		)
+		return
+	}
+
+	ruleSet, err := state.current(config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading rule set",
+			"Could not decode rule set of "+subjectLabel(subject)+": "+err.Error(),
+		)
+		return
+	}
+
+	// Rule set was removed outside of Terraform
+	if ruleSet == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.DomainRules, diags = ruleModelsFrom(ctx, ruleSet.DomainRules)
+	resp.Diagnostics.Append(diags...)
+	state.MigrationRules, diags = ruleModelsFrom(ctx, ruleSet.MigrationRules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ruleSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan ruleSetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the rule set and keeps the other config fields.
+func (r *ruleSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state ruleSetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if state.shared() {
+		resp.Diagnostics.AddWarning(
+			"Rule set left in place",
+			fmt.Sprintf("The %s of %s is not removed, Schema Registry can only remove it by deleting the config every subject inherits.",
+				state.field(), subjectLabel(state.subject())),
+		)
+		return
+	}
+
+	// Compatibility level is kept as it is, it may be managed by foxcon_subject_compatibility
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s of %s", state.field(), subjectLabel(state.subject())))
+	err = ClearSubjectConfigFields(ctx, schemaAPIClient, state.subject(), []string{state.field()}, []string{"compatibilityLevel"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting rule set",
+			"Could not delete rule set: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ruleSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.clients = clients
+}
+
+// ImportState imports a rule set. Import ID is `default` or `override`, followed by a slash and the
+// subject name for subject level rule sets, e.g. "override/orders-value" or "default/:.staging:".
+func (r *ruleSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	kind, name, _ := strings.Cut(req.ID, "/")
+	if kind != "default" && kind != "override" {
+		resp.Diagnostics.AddError(
+			"Import error",
+			fmt.Sprintf("Import ID '%s' must start with 'default' or 'override'.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("override"), kind == "override")...)
+
+	schemaContext, subject := parseQualifiedSubject(name)
+	if subject != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject_name"), subject)...)
+	}
+	if schemaContext != "" && schemaContext != defaultContext {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context"), schemaContext)...)
+	}
+
+	importSchemaRegistryCredentials(ctx, resp)
+}
@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func ruleSetResourceConfig(subject string, rules string) string {
+	return schemaProviderConfig + `
+resource "foxcon_rule_set" "test" {
+  subject_name = "` + subject + `"
+` + rules + `
+}
+`
+}
+
+func ruleSetContextResourceConfig(schemaContext string, rules string) string {
+	return schemaProviderConfig + `
+resource "foxcon_rule_set" "test" {
+  context = "` + schemaContext + `"
+` + rules + `
+}
+`
+}
+
+const (
+	ruleSetDomainRule = `
+  domain_rule {
+    name       = "check-id"
+    kind       = "CONDITION"
+    mode       = "WRITE"
+    type       = "CEL"
+    expr       = "message.id > 0"
+    on_failure = "ERROR"
+  }
+`
+	ruleSetMigrationRule = `
+  migration_rule {
+    name = "rename-id"
+    kind = "TRANSFORM"
+    mode = "UPDOWN"
+    type = "JSONATA"
+    expr = "$merge([$sift($, function($v, $k) {$k != 'id'}), {'identifier': $.'id'}])"
+    params = {
+      "owner" = "orders"
+    }
+    on_failure = "NONE,ERROR"
+  }
+`
+)
+
+func TestRuleSetResource(t *testing.T) {
+
+	subject := "rule-set"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ruleSetResourceConfig(subject, ruleSetDomainRule),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "override", "false"),
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "domain_rule.#", "1"),
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "domain_rule.0.name", "check-id"),
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "migration_rule.#", "0"),
+				),
+			},
+			// Adding a rule is merged into the rule set
+			{
+				Config: ruleSetResourceConfig(subject, ruleSetDomainRule+ruleSetMigrationRule),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "domain_rule.#", "1"),
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "migration_rule.#", "1"),
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "migration_rule.0.params.owner", "orders"),
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "migration_rule.0.on_failure", "NONE,ERROR"),
+				),
+			},
+			// Removing a rule replaces the rule set
+			{
+				Config: ruleSetResourceConfig(subject, ruleSetMigrationRule),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "domain_rule.#", "0"),
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "migration_rule.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "foxcon_rule_set.test",
+				ImportState:                          true,
+				ImportStateId:                        "default/" + subject,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subject_name",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestRuleSetResourceContext(t *testing.T) {
+
+	schemaContext := ".rule-set"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ruleSetContextResourceConfig(schemaContext, ruleSetDomainRule),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("foxcon_rule_set.test", "subject_name"),
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "domain_rule.#", "1"),
+				),
+			},
+			// Removing a rule would delete the context config
+			{
+				Config:      ruleSetContextResourceConfig(schemaContext, ruleSetMigrationRule),
+				ExpectError: regexp.MustCompile(`Rule set can not be merged`),
+			},
+			{
+				Config: ruleSetContextResourceConfig(schemaContext, ruleSetDomainRule+ruleSetMigrationRule),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_rule_set.test", "migration_rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestRuleSetResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ruleSetResourceConfig("rule-set-invalid", ""),
+				ExpectError: regexp.MustCompile(`At\s+least\s+one\s+'domain_rule'\s+or\s+'migration_rule'`),
+			},
+			{
+				Config:      ruleSetResourceConfig("rule-set-invalid", ruleSetDomainRule+ruleSetDomainRule),
+				ExpectError: regexp.MustCompile(`Rule\s+'check-id'\s+is\s+defined\s+more\s+than\s+once`),
+			},
+			{
+				Config: ruleSetResourceConfig("rule-set-invalid", `
+  domain_rule {
+    name       = "check-id"
+    kind       = "CONDITION"
+    mode       = "WRITE"
+    type       = "CEL"
+    on_failure = "NONE,ERROR"
+  }
+`),
+				ExpectError: regexp.MustCompile(`expr\s+must\s+be\s+set\s+for\s+CEL\s+rules`),
+			},
+			{
+				Config: ruleSetResourceConfig("rule-set-invalid", `
+  migration_rule {
+    name = "rename-id"
+    kind = "TRANSFORM"
+    mode = "WRITE"
+    type = "JSONATA"
+    expr = "$"
+  }
+`),
+				ExpectError: regexp.MustCompile(`value\s+must\s+be\s+one\s+of`),
+			},
+		},
+	})
+}
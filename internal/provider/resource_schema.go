@@ -0,0 +1,586 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &schemaResource{}
+	_ resource.ResourceWithConfigure      = &schemaResource{}
+	_ resource.ResourceWithValidateConfig = &schemaResource{}
+	_ resource.ResourceWithImportState    = &schemaResource{}
+)
+
+// NewSchemaResource is a helper function to simplify the provider implementation.
+func NewSchemaResource() resource.Resource {
+	return &schemaResource{}
+}
+
+// schemaResource is the resource implementation.
+type schemaResource struct {
+	clients *providerClients
+}
+
+// Metadata returns the resource type name.
+func (r *schemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema"
+}
+
+// Schema defines the schema for the resource.
+func (r *schemaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registers a schema under a subject. Every change of the schema registers a new version of the subject.",
+		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"subject_name": schema.StringAttribute{
+				Required:    true,
+				Description: subjectNameDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context": resourceContextAttribute(),
+			"schema": schema.StringAttribute{
+				Required:    true,
+				Description: "The schema definition.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"schema_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(schemaTypeAvro),
+				Description: "The schema type. Accepted values are: `AVRO`, `JSON` and `PROTOBUF`. Defaults to `AVRO`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(schemaTypeAvro, schemaTypeJSON, schemaTypeProtobuf),
+				},
+			},
+			"normalize": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Normalize the schema before it is registered. Defaults to `false`.",
+			},
+			"rule_set": schema.StringAttribute{
+				Optional:    true,
+				Description: "Data contract rule set of the schema as a JSON document.",
+				Validators: []validator.String{
+					JSONValidator{},
+				},
+			},
+			"hard_delete": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Permanently delete the schema version on destroy instead of a soft delete. Defaults to `false`.",
+			},
+			"schema_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Globally unique identifier of the schema.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Version of the subject the schema is registered as.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"reference": schema.ListNestedBlock{
+				Description: "Schema referenced by this schema.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the reference as used in the schema. A file name for PROTOBUF, a fully qualified name for AVRO and a URL for JSON.",
+						},
+						"subject": schema.StringAttribute{
+							Required:    true,
+							Description: "Subject of the referenced schema.",
+						},
+						"version": schema.Int64Attribute{
+							Required:    true,
+							Description: "Version of the referenced schema.",
+						},
+					},
+				},
+			},
+			"metadata": resourceMetadataBlock("Data contract metadata of the schema."),
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type schemaResourceModel struct {
+	RestEndpoint types.String           `tfsdk:"rest_endpoint"`
+	Registry     types.String           `tfsdk:"registry"`
+	SubjectName  types.String           `tfsdk:"subject_name"`
+	Context      types.String           `tfsdk:"context"`
+	Schema       types.String           `tfsdk:"schema"`
+	SchemaType   types.String           `tfsdk:"schema_type"`
+	Normalize    types.Bool             `tfsdk:"normalize"`
+	RuleSet      types.String           `tfsdk:"rule_set"`
+	HardDelete   types.Bool             `tfsdk:"hard_delete"`
+	SchemaID     types.Int64            `tfsdk:"schema_id"`
+	Version      types.Int64            `tfsdk:"version"`
+	References   []schemaReferenceModel `tfsdk:"reference"`
+	Metadata     *schemaMetadataModel   `tfsdk:"metadata"`
+	Credentials  *credentialsModel      `tfsdk:"credentials"`
+	TLS          *tlsModel              `tfsdk:"tls"`
+	Timeouts     timeouts.Value         `tfsdk:"timeouts"`
+}
+
+// subject returns the subject name qualified with its context.
+func (m *schemaResourceModel) subject() string {
+	return qualifiedSubject(m.Context, m.SubjectName)
+}
+
+type schemaReferenceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Subject types.String `tfsdk:"subject"`
+	Version types.Int64  `tfsdk:"version"`
+}
+
+// payload builds the register request out of the resource model.
+func (m *schemaResourceModel) payload(ctx context.Context) (RegisterSchemaRequest, diag.Diagnostics) {
+	metadata, diags := m.Metadata.metadata(ctx)
+
+	payload := RegisterSchemaRequest{
+		Schema:     m.Schema.ValueString(),
+		SchemaType: m.SchemaType.ValueString(),
+		Metadata:   metadata,
+	}
+
+	for _, reference := range m.References {
+		payload.References = append(payload.References, SchemaReference{
+			Name:    reference.Name.ValueString(),
+			Subject: reference.Subject.ValueString(),
+			Version: int(reference.Version.ValueInt64()),
+		})
+	}
+
+	if !m.RuleSet.IsNull() {
+		payload.RuleSet = json.RawMessage(m.RuleSet.ValueString())
+	}
+
+	return payload, diags
+}
+
+// setSchema copies the registered schema definition into the resource model.
+func (m *schemaResourceModel) setSchema(ctx context.Context, registered *SchemaResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Schema = types.StringValue(registered.Schema)
+	m.SchemaType = types.StringValue(registered.Type())
+
+	m.References = []schemaReferenceModel{}
+	for _, reference := range registered.References {
+		m.References = append(m.References, schemaReferenceModel{
+			Name:    types.StringValue(reference.Name),
+			Subject: types.StringValue(reference.Subject),
+			Version: types.Int64Value(int64(reference.Version)),
+		})
+	}
+
+	m.Metadata, diags = newSchemaMetadataModel(ctx, registered.Metadata)
+
+	m.RuleSet = types.StringNull()
+	if len(registered.RuleSet) > 0 && string(registered.RuleSet) != "null" {
+		m.RuleSet = types.StringValue(string(registered.RuleSet))
+	}
+
+	return diags
+}
+
+func (r *schemaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config schemaResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateResourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// register registers the planned schema and fills in its id and version.
+func (r *schemaResource) register(ctx context.Context, plan *schemaResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: plan.RestEndpoint,
+		Registry:     plan.Registry,
+		Credentials:  plan.Credentials,
+		TLS:          plan.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
+	if err != nil {
+		diags.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	payload, d := plan.payload(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	subject := plan.subject()
+	normalize := plan.Normalize.ValueBool()
+
+	_, err = RegisterSchema(ctx, schemaAPIClient, subject, payload, normalize)
+	if err != nil {
+		diags.AddError(
+			"Error registering schema",
+			"Could not register schema under subject "+subject+": "+err.Error(),
+		)
+		return diags
+	}
+
+	// Register response contains the id only
+	registered, err := LookupSchema(ctx, schemaAPIClient, subject, payload, normalize)
+	if err != nil {
+		diags.AddError(
+			"Error reading registered schema",
+			"Could not read registered schema of subject "+subject+": "+err.Error(),
+		)
+		return diags
+	}
+	if registered == nil {
+		diags.AddError(
+			"Error reading registered schema",
+			"Registered schema was not found under subject "+subject+".",
+		)
+		return diags
+	}
+
+	latest, err := GetSchemaVersion(ctx, schemaAPIClient, subject, "latest", false)
+	if err != nil {
+		diags.AddError(
+			"Error reading latest schema version",
+			"Could not read latest version of subject "+subject+": "+err.Error(),
+		)
+		return diags
+	}
+
+	// Schema Registry returns the existing version when the schema has already been registered, the state
+	// would follow the latest version on the next refresh and never converge
+	if latest != nil && latest.Version != registered.Version {
+		diags.AddError(
+			"Schema is not the latest version",
+			fmt.Sprintf("Schema is already registered as version %d of subject %s and was not registered again. The latest version of the subject is %d. "+
+				"Delete the later versions or change the schema to register it as a new version.", registered.Version, subject, latest.Version),
+		)
+		return diags
+	}
+
+	plan.SchemaID = types.Int64Value(int64(registered.ID))
+	plan.Version = types.Int64Value(int64(registered.Version))
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *schemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan schemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.register(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *schemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state schemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: state.RestEndpoint,
+		Registry:     state.Registry,
+		Credentials:  state.Credentials,
+		TLS:          state.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	latest, err := GetSchemaVersion(ctx, schemaAPIClient, state.subject(), "latest", false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading schema",
+			"Could not read latest version of subject "+state.subject()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Subject has been deleted outside of Terraform
+	if latest == nil {
+		tflog.Debug(ctx, fmt.Sprintf("Subject %s has no active versions, removing schema from state", state.subject()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Schema Registry canonicalizes registered schemas, so the definition is only
+	// copied into the state once the latest version is a different schema.
+	if state.SchemaID.ValueInt64() != int64(latest.ID) || state.Version.ValueInt64() != int64(latest.Version) {
+		tflog.Debug(ctx, fmt.Sprintf("Latest version of subject %s changed to %d", state.subject(), latest.Version))
+		resp.Diagnostics.Append(state.setSchema(ctx, latest)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state.SchemaID = types.Int64Value(int64(latest.ID))
+	state.Version = types.Int64Value(int64(latest.Version))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update registers the changed schema as a new version and sets the updated Terraform state on success.
+func (r *schemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan schemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.register(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the registered schema version and removes the Terraform state on success.
+func (r *schemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state schemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: state.RestEndpoint,
+		Registry:     state.Registry,
+		Credentials:  state.Credentials,
+		TLS:          state.TLS,
+	}
+
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	subject := state.subject()
+	version := int(state.Version.ValueInt64())
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting version %d of subject %s", version, subject))
+	err = DeleteSchemaVersion(ctx, schemaAPIClient, subject, version, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting schema",
+			"Could not delete schema: "+err.Error(),
+		)
+		return
+	}
+
+	// Permanent deletion is only allowed for soft deleted versions
+	if state.HardDelete.ValueBool() {
+		err = DeleteSchemaVersion(ctx, schemaAPIClient, subject, version, true)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting schema",
+				"Could not permanently delete schema: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *schemaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.clients = clients
+}
+
+// ImportState imports the latest version of a subject. Import ID is the subject name.
+func (r *schemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSubject(ctx, req.ID, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("normalize"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hard_delete"), false)...)
+	importSchemaRegistryCredentials(ctx, resp)
+}
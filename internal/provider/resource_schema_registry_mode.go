@@ -0,0 +1,366 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &schemaRegistryModeResource{}
+	_ resource.ResourceWithConfigure      = &schemaRegistryModeResource{}
+	_ resource.ResourceWithValidateConfig = &schemaRegistryModeResource{}
+	_ resource.ResourceWithImportState    = &schemaRegistryModeResource{}
+)
+
+// NewSchemaRegistryModeResource is a helper function to simplify the provider implementation.
+func NewSchemaRegistryModeResource() resource.Resource {
+	return &schemaRegistryModeResource{}
+}
+
+// schemaRegistryModeResource is the resource implementation.
+type schemaRegistryModeResource struct {
+	clients *providerClients
+}
+
+// Metadata returns the resource type name.
+func (r *schemaRegistryModeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_registry_mode"
+}
+
+// Schema defines the schema for the resource.
+func (r *schemaRegistryModeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the global schema registry mode. Destroying the resource sets the mode back to `READWRITE`.",
+		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Required:    true,
+				Description: globalModeDescription,
+				Validators: []validator.String{
+					stringvalidator.OneOf(subjectModes...),
+				},
+			},
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: modeForceDescription + " Defaults to `false`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type schemaRegistryModeResourceModel struct {
+	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
+	Mode         types.String      `tfsdk:"mode"`
+	Force        types.Bool        `tfsdk:"force"`
+	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
+	Timeouts     timeouts.Value    `tfsdk:"timeouts"`
+}
+
+func (m *schemaRegistryModeResourceModel) client(clients *providerClients) (*Client, error) {
+	creds := schemaRegistryCredentials{
+		RestEndpoint: m.RestEndpoint,
+		Registry:     m.Registry,
+		Credentials:  m.Credentials,
+		TLS:          m.TLS,
+	}
+
+	return schemaRegistryClientFactory(clients, &creds)
+}
+
+func (r *schemaRegistryModeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config schemaRegistryModeResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateResourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *schemaRegistryModeResource) setMode(ctx context.Context, plan *schemaRegistryModeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaAPIClient, err := plan.client(r.clients)
+	if err != nil {
+		diags.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	err = CheckImportMode(ctx, schemaAPIClient, "", plan.Mode.ValueString(), plan.Force.ValueBool())
+	if err != nil {
+		diags.AddError(
+			"Error setting global mode",
+			"Could not set global mode: "+err.Error(),
+		)
+		return diags
+	}
+
+	response, err := SetGlobalMode(ctx, schemaAPIClient, SubjectModeRequest{Mode: plan.Mode.ValueString()}, plan.Force.ValueBool())
+	if err != nil {
+		diags.AddError(
+			"Error setting global mode",
+			"Could not set global mode: "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.Mode = types.StringValue(response.Mode)
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *schemaRegistryModeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan schemaRegistryModeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.setMode(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *schemaRegistryModeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state schemaRegistryModeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	globalMode, err := GetGlobalMode(ctx, schemaAPIClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading global mode",
+			"Could not read global mode: "+err.Error(),
+		)
+		return
+	}
+
+	state.Mode = types.StringValue(globalMode.Mode)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *schemaRegistryModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan schemaRegistryModeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.setMode(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete sets the global mode back to the Schema Registry default. Global mode can not be deleted.
+func (r *schemaRegistryModeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state schemaRegistryModeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting global mode to %s", defaultMode))
+	_, err = SetGlobalMode(ctx, schemaAPIClient, SubjectModeRequest{Mode: defaultMode}, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error setting global mode",
+			"Could not set global mode to "+defaultMode+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *schemaRegistryModeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.clients = clients
+}
+
+// ImportState imports the global mode. Import ID is not used.
+func (r *schemaRegistryModeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force"), false)...)
+	importSchemaRegistryCredentials(ctx, resp)
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func schemaRegistryModeResourceConfig(mode string) string {
+	return schemaProviderConfig + `
+resource "foxcon_schema_registry_mode" "test" {
+  mode = "` + mode + `"
+}
+`
+}
+
+func TestSchemaRegistryModeResource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: schemaRegistryModeResourceConfig("READONLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_schema_registry_mode.test", "mode", "READONLY"),
+					resource.TestCheckResourceAttr("foxcon_schema_registry_mode.test", "force", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "foxcon_schema_registry_mode.test",
+				ImportState:                          true,
+				ImportStateId:                        "global",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "mode",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
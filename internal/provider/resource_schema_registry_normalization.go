@@ -9,6 +9,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -31,7 +32,7 @@ func NewSchemaRegistryNormalizationResource() resource.Resource {
 
 // schemaRegistryNormalizationResource is the resource implementation.
 type schemaRegistryNormalizationResource struct {
-	client *Client
+	clients *providerClients
 }
 
 // Metadata returns the resource type name.
@@ -40,9 +41,10 @@ func (r *schemaRegistryNormalizationResource) Metadata(_ context.Context, req re
 }
 
 // Schema defines the schema for the resource.
-func (r *schemaRegistryNormalizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *schemaRegistryNormalizationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
 			"rest_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: restEndpointDescription,
@@ -70,6 +72,12 @@ func (r *schemaRegistryNormalizationResource) Schema(_ context.Context, _ resour
 					},
 				},
 			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 		MarkdownDescription: "Sets schema registry normalization.",
 	}
@@ -77,9 +85,12 @@ func (r *schemaRegistryNormalizationResource) Schema(_ context.Context, _ resour
 
 type schemaRegistryNormalizationResourceModel struct {
 	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
 	Normalize    types.Bool        `tfsdk:"normalization_enabled"`
 	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
 	LastUpdated  types.String      `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value    `tfsdk:"timeouts"`
 }
 
 func (r *schemaRegistryNormalizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -93,7 +104,9 @@ func (r *schemaRegistryNormalizationResource) ValidateConfig(ctx context.Context
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
 		Credentials:  config.Credentials,
+		TLS:          config.TLS,
 	}
 
 	creds.ValidateResourceConfig(resp)
@@ -114,12 +127,23 @@ func (r *schemaRegistryNormalizationResource) Create(ctx context.Context, req re
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	creds := schemaRegistryCredentials{
 		RestEndpoint: plan.RestEndpoint,
+		Registry:     plan.Registry,
 		Credentials:  plan.Credentials,
+		TLS:          plan.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(r.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -133,7 +157,7 @@ func (r *schemaRegistryNormalizationResource) Create(ctx context.Context, req re
 	}
 
 	// Set Normalization
-	schemaConfig, err := SetSubjectConfig(schemaAPIClient, "", normalizationPayload)
+	schemaConfig, err := SetSubjectConfig(ctx, schemaAPIClient, "", normalizationPayload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error setting normalization",
@@ -168,10 +192,12 @@ func (r *schemaRegistryNormalizationResource) Read(ctx context.Context, req reso
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: state.RestEndpoint,
+		Registry:     state.Registry,
 		Credentials:  state.Credentials,
+		TLS:          state.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(r.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -181,7 +207,7 @@ func (r *schemaRegistryNormalizationResource) Read(ctx context.Context, req reso
 	}
 
 	// Get schema config
-	schemaConfig, err := GetSubjectConfig(schemaAPIClient, "")
+	schemaConfig, err := GetSubjectConfig(ctx, schemaAPIClient, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Schema config",
@@ -212,12 +238,23 @@ func (r *schemaRegistryNormalizationResource) Update(ctx context.Context, req re
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	creds := schemaRegistryCredentials{
 		RestEndpoint: plan.RestEndpoint,
+		Registry:     plan.Registry,
 		Credentials:  plan.Credentials,
+		TLS:          plan.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(r.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -230,7 +267,7 @@ func (r *schemaRegistryNormalizationResource) Update(ctx context.Context, req re
 		Normalize: plan.Normalize.ValueBoolPointer(),
 	}
 
-	schemaConfig, err := SetSubjectConfig(schemaAPIClient, "", normalizationPayload)
+	schemaConfig, err := SetSubjectConfig(ctx, schemaAPIClient, "", normalizationPayload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Setting Subject config",
@@ -260,12 +297,23 @@ func (r *schemaRegistryNormalizationResource) Delete(ctx context.Context, req re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	creds := schemaRegistryCredentials{
 		RestEndpoint: state.RestEndpoint,
+		Registry:     state.Registry,
 		Credentials:  state.Credentials,
+		TLS:          state.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(r.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -281,7 +329,7 @@ func (r *schemaRegistryNormalizationResource) Delete(ctx context.Context, req re
 		Normalize: nil,
 	}
 
-	_, err = SetSubjectConfig(schemaAPIClient, "", normalizationPayload)
+	_, err = SetSubjectConfig(ctx, schemaAPIClient, "", normalizationPayload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error setting normalization value to null",
@@ -311,7 +359,7 @@ func (r *schemaRegistryNormalizationResource) Configure(_ context.Context, req r
 		return
 	}
 
-	r.client = clients.SchemaRegistryClient
+	r.clients = clients
 }
 
 func (r *schemaRegistryNormalizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// schemaResourceConfig returns a foxcon_schema resource registering the tests/schemas/v<version>.json fixture.
+func schemaResourceConfig(subject string, version int) string {
+	schema, err := readSchemaFixture(version)
+	if err != nil {
+		panic(err)
+	}
+
+	return schemaProviderConfig + `
+resource "foxcon_schema" "test" {
+  subject_name = "` + subject + `"
+  schema_type = "JSON"
+  hard_delete = true
+  schema = <<-EOT
+` + schema + `
+EOT
+}
+`
+}
+
+func TestSchemaResource(t *testing.T) {
+
+	subject := "schema-resource"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					// Fixtures add properties to an open content model
+					_, _, err := callSchemaRegistry("PUT", fmt.Sprintf("%s/config/%s", rest_endpoint, subject), bytes.NewBufferString(`{"compatibility": "NONE"}`))
+					if err != nil {
+						panic(err)
+					}
+				},
+				Config: schemaResourceConfig(subject, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_schema.test", "subject_name", subject),
+					resource.TestCheckResourceAttr("foxcon_schema.test", "schema_type", "JSON"),
+					resource.TestCheckResourceAttr("foxcon_schema.test", "normalize", "false"),
+					resource.TestCheckResourceAttr("foxcon_schema.test", "version", "1"),
+					resource.TestCheckResourceAttrSet("foxcon_schema.test", "schema_id"),
+				),
+			},
+			// Update registers a new version
+			{
+				Config: schemaResourceConfig(subject, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_schema.test", "version", "2"),
+					resource.TestCheckResourceAttrSet("foxcon_schema.test", "schema_id"),
+				),
+			},
+			// Version registered outside of Terraform is detected as drift
+			{
+				PreConfig: func() {
+					if err := addSubjectVersions(subject, []int{3}); err != nil {
+						panic(err)
+					}
+				},
+				Config:             schemaResourceConfig(subject, 2),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: schemaResourceConfig(subject, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_schema.test", "version", "4"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "foxcon_schema.test",
+				ImportState:                          true,
+				ImportStateId:                        subject,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subject_name",
+				ImportStateVerifyIgnore:              []string{"schema", "hard_delete"},
+			},
+			// Reverting to an earlier version does not register it again
+			{
+				Config:      schemaResourceConfig(subject, 2),
+				ExpectError: regexp.MustCompile(`Schema is not the latest version`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
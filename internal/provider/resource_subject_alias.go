@@ -0,0 +1,394 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &subjectAliasResource{}
+	_ resource.ResourceWithConfigure      = &subjectAliasResource{}
+	_ resource.ResourceWithValidateConfig = &subjectAliasResource{}
+	_ resource.ResourceWithImportState    = &subjectAliasResource{}
+)
+
+// NewSubjectAliasResource is a helper function to simplify the provider implementation.
+func NewSubjectAliasResource() resource.Resource {
+	return &subjectAliasResource{}
+}
+
+// subjectAliasResource is the resource implementation.
+type subjectAliasResource struct {
+	clients *providerClients
+}
+
+// Metadata returns the resource type name.
+func (r *subjectAliasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subject_alias"
+}
+
+// Schema defines the schema for the resource.
+func (r *subjectAliasResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets `alias` on a subject config, so the subject resolves to another subject. Destroying the resource removes only the alias, other config fields are kept.",
+		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"subject_name": schema.StringAttribute{
+				Required:    true,
+				Description: subjectNameDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context": resourceContextAttribute(),
+			"alias": schema.StringAttribute{
+				Required:    true,
+				Description: aliasDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type subjectAliasResourceModel struct {
+	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
+	SubjectName  types.String      `tfsdk:"subject_name"`
+	Context      types.String      `tfsdk:"context"`
+	Alias        types.String      `tfsdk:"alias"`
+	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
+	Timeouts     timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// subject returns the subject name qualified with its context.
+func (m *subjectAliasResourceModel) subject() string {
+	return qualifiedSubject(m.Context, m.SubjectName)
+}
+
+func (m *subjectAliasResourceModel) client(clients *providerClients) (*Client, error) {
+	creds := schemaRegistryCredentials{
+		RestEndpoint: m.RestEndpoint,
+		Registry:     m.Registry,
+		Credentials:  m.Credentials,
+		TLS:          m.TLS,
+	}
+
+	return schemaRegistryClientFactory(clients, &creds)
+}
+
+func (r *subjectAliasResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config subjectAliasResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateResourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Alias.IsUnknown() && !config.SubjectName.IsUnknown() && !config.Context.IsUnknown() &&
+		config.Alias.ValueString() == config.subject() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("alias"),
+			"Invalid alias",
+			"A subject can not be an alias for itself.",
+		)
+	}
+}
+
+// setAlias sets the alias on the subject config. Subject versions are checked unless the subject is an alias already.
+func (r *subjectAliasResource) setAlias(ctx context.Context, plan *subjectAliasResourceModel, check bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaAPIClient, err := plan.client(r.clients)
+	if err != nil {
+		diags.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if check {
+		err = CheckSubjectAlias(ctx, schemaAPIClient, plan.subject())
+		if err != nil {
+			diags.AddError(
+				"Error setting subject alias",
+				"Could not set alias of subject "+plan.subject()+": "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	err = UpdateSubjectConfig(ctx, schemaAPIClient, plan.subject(), SubjectConfigRequest{Alias: plan.Alias.ValueStringPointer()})
+	if err != nil {
+		diags.AddError(
+			"Error setting subject alias",
+			"Could not set alias of subject "+plan.subject()+": "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *subjectAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subjectAliasResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.setAlias(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *subjectAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state subjectAliasResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	subjectConfig, err := GetSubjectConfig(ctx, schemaAPIClient, state.subject())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Subject alias",
+			"Could not read Subject config "+state.subject()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Alias was removed outside of Terraform
+	if subjectConfig == nil || subjectConfig.Alias == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Alias = types.StringValue(*subjectConfig.Alias)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *subjectAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan subjectAliasResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Subject is an alias already, its versions were checked on create
+	resp.Diagnostics.Append(r.setAlias(ctx, &plan, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the alias and keeps the rest of the subject config.
+func (r *subjectAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state subjectAliasResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting %s subject alias", state.subject()))
+	err = ClearSubjectConfigFields(ctx, schemaAPIClient, state.subject(), []string{"alias"}, []string{"compatibilityLevel"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting subject alias",
+			"Could not delete subject alias: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subjectAliasResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.clients = clients
+}
+
+// ImportState imports the alias of a subject. Import ID is the subject name.
+func (r *subjectAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSubject(ctx, req.ID, resp)
+	importSchemaRegistryCredentials(ctx, resp)
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func subjectAliasResourceConfig(subject string, alias string) string {
+	return schemaProviderConfig + `
+resource "foxcon_subject_alias" "test" {
+  subject_name = "` + subject + `"
+  alias = "` + alias + `"
+}
+`
+}
+
+func TestSubjectAliasResource(t *testing.T) {
+
+	subject := "subject-alias-old"
+	target := "subject-alias-new"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					if err := addSubjectVersions(target, []int{1}); err != nil {
+						panic(err)
+					}
+					// Normalization of the old subject is kept once the alias is removed
+					if _, _, err := callSchemaRegistry("PUT", fmt.Sprintf("%s/config/%s", rest_endpoint, subject), bytes.NewBufferString(`{"normalize": true}`)); err != nil {
+						panic(err)
+					}
+				},
+				Config: subjectAliasResourceConfig(subject, target),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_subject_alias.test", "subject_name", subject),
+					resource.TestCheckResourceAttr("foxcon_subject_alias.test", "alias", target),
+					func(_ *terraform.State) error {
+						return validateSubjectConfigFields(subject, []string{"alias", "normalize"})
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "foxcon_subject_alias.test",
+				ImportState:                          true,
+				ImportStateId:                        subject,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subject_name",
+			},
+			// Destroy testing
+			{
+				Config: schemaProviderConfig + "",
+				Check: func(_ *terraform.State) error {
+					return validateSubjectConfigFields(subject, []string{"normalize"})
+				},
+			},
+		},
+	})
+}
+
+func TestSubjectAliasResourceRefusesSubjectWithVersions(t *testing.T) {
+
+	subject := "subject-alias-versions"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := addSubjectVersions(subject, []int{1}); err != nil {
+						panic(err)
+					}
+				},
+				Config:      subjectAliasResourceConfig(subject, "subject-alias-new"),
+				ExpectError: regexp.MustCompile(`has\s+1\s+versions\s+of\s+its\s+own`),
+			},
+		},
+	})
+}
@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,6 +24,7 @@ import (
 var (
 	_ resource.Resource                = &subjectCleanupResource{}
 	_ resource.ResourceWithConfigure   = &subjectCleanupResource{}
+	_ resource.ResourceWithModifyPlan  = &subjectCleanupResource{}
 	_ resource.ResourceWithImportState = &subjectCleanupResource{}
 )
 
@@ -32,7 +35,7 @@ func NewSubjectCleanupResource() resource.Resource {
 
 // subjectCleanupResource is the resource implementation.
 type subjectCleanupResource struct {
-	client *Client
+	clients *providerClients
 }
 
 // Metadata returns the resource type name.
@@ -41,10 +44,11 @@ func (r *subjectCleanupResource) Metadata(_ context.Context, req resource.Metada
 }
 
 // Schema defines the schema for the resource.
-func (r *subjectCleanupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *subjectCleanupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Deletes schema versions depending on the configured clean-up method.",
 		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
 			"rest_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Schema registry rest endpoint.",
@@ -65,6 +69,7 @@ func (r *subjectCleanupResource) Schema(_ context.Context, _ resource.SchemaRequ
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"context": resourceContextAttribute(),
 			"cleanup_method": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
@@ -80,6 +85,15 @@ func (r *subjectCleanupResource) Schema(_ context.Context, _ resource.SchemaRequ
 					SchemasNumberValidator{},
 				},
 			},
+			"referenced_versions": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("SKIP"),
+				Description: "Handling of versions referenced by other schemas, which are never deleted. With `SKIP` they count toward the versions the cleanup method keeps, with `KEEP` they are kept on top of them. Accepted values are: `SKIP` and `KEEP`. Defaults to `SKIP`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("SKIP", "KEEP"),
+				},
+			},
 			"latest_schema_version": schema.Int32Attribute{
 				Computed:    true,
 				Description: "Last schema version number.",
@@ -95,6 +109,16 @@ func (r *subjectCleanupResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:    true,
 				Description: "List of schema versions deleted on the last apply execution.",
 			},
+			"skipped_referenced": schema.ListAttribute{
+				ElementType: types.Int32Type,
+				Computed:    true,
+				Description: "List of schema versions left in place on the last apply execution because other schemas reference them.",
+			},
+			"planned_deletions": schema.ListAttribute{
+				ElementType: types.Int32Type,
+				Computed:    true,
+				Description: "List of schema versions the next apply execution permanently deletes, computed during the plan. The apply does not delete versions missing from the list. Unknown when the connection or the cleanup settings are only known on apply.",
+			},
 			"last_updated": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp of the last apply execution.",
@@ -132,20 +156,38 @@ func (r *subjectCleanupResource) Schema(_ context.Context, _ resource.SchemaRequ
 					},
 				},
 			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 type subjectCleanupResourceModel struct {
-	RestEndpoint      types.String      `tfsdk:"rest_endpoint"`
-	SubjectName       types.String      `tfsdk:"subject_name"`
-	Credentials       *credentialsModel `tfsdk:"credentials"`
-	SchemasToKeep     types.Int64       `tfsdk:"number_of_schemas_to_keep"`
-	LastSchemaVersion types.Int32       `tfsdk:"latest_schema_version"`
-	CleanupNeeded     types.Bool        `tfsdk:"cleanup_needed"`
-	CleanupMethod     types.String      `tfsdk:"cleanup_method"`
-	LastDeleted       types.List        `tfsdk:"last_deleted"`
-	LastUpdated       types.String      `tfsdk:"last_updated"`
+	RestEndpoint       types.String      `tfsdk:"rest_endpoint"`
+	Registry           types.String      `tfsdk:"registry"`
+	SubjectName        types.String      `tfsdk:"subject_name"`
+	Context            types.String      `tfsdk:"context"`
+	Credentials        *credentialsModel `tfsdk:"credentials"`
+	TLS                *tlsModel         `tfsdk:"tls"`
+	SchemasToKeep      types.Int64       `tfsdk:"number_of_schemas_to_keep"`
+	LastSchemaVersion  types.Int32       `tfsdk:"latest_schema_version"`
+	CleanupNeeded      types.Bool        `tfsdk:"cleanup_needed"`
+	CleanupMethod      types.String      `tfsdk:"cleanup_method"`
+	ReferencedVersions types.String      `tfsdk:"referenced_versions"`
+	LastDeleted        types.List        `tfsdk:"last_deleted"`
+	SkippedReferenced  types.List        `tfsdk:"skipped_referenced"`
+	PlannedDeletions   types.List        `tfsdk:"planned_deletions"`
+	LastUpdated        types.String      `tfsdk:"last_updated"`
+	Timeouts           timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// subject returns the subject name qualified with its context.
+func (m *subjectCleanupResourceModel) subject() string {
+	return qualifiedSubject(m.Context, m.SubjectName)
 }
 
 func (r *subjectCleanupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -159,7 +201,9 @@ func (r *subjectCleanupResource) ValidateConfig(ctx context.Context, req resourc
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
 		Credentials:  config.Credentials,
+		TLS:          config.TLS,
 	}
 
 	creds.ValidateResourceConfig(resp)
@@ -182,7 +226,16 @@ func (r *subjectCleanupResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	diags, err = SubjectCleanup(ctx, r.client, &plan)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	diags, err = SubjectCleanup(ctx, r.clients, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -216,7 +269,7 @@ func (r *subjectCleanupResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	subjectVersions, err := ReadSubjectVersions(ctx, r.client, state)
+	subjectVersions, err := ReadSubjectVersions(ctx, r.clients, state)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -231,6 +284,12 @@ func (r *subjectCleanupResource) Read(ctx context.Context, req resource.ReadRequ
 		state.CleanupNeeded = types.BoolValue(false)
 	}
 
+	state.PlannedDeletions, diags = subjectVersions.plannedDeletions()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -239,6 +298,58 @@ func (r *subjectCleanupResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 }
 
+// ModifyPlan lists the schema versions the apply is going to delete.
+func (r *subjectCleanupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan subjectCleanupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SubjectName.IsUnknown() || plan.Context.IsUnknown() || plan.CleanupMethod.IsUnknown() || plan.ReferencedVersions.IsUnknown() ||
+		plan.RestEndpoint.IsUnknown() || plan.Registry.IsUnknown() {
+		return
+	}
+
+	if plan.CleanupMethod.ValueString() == "MAX_STORED_SCHEMAS" && plan.SchemasToKeep.IsUnknown() {
+		return
+	}
+
+	if plan.Credentials != nil && (plan.Credentials.Key.IsUnknown() || plan.Credentials.Secret.IsUnknown()) {
+		return
+	}
+
+	if plan.TLS != nil && (plan.TLS.CACertificate.IsUnknown() || plan.TLS.ClientCertificate.IsUnknown() ||
+		plan.TLS.ClientKey.IsUnknown() || plan.TLS.ServerName.IsUnknown()) {
+		return
+	}
+
+	subjectVersions, err := ReadSubjectVersions(ctx, r.clients, plan)
+	if err != nil {
+		// Deletions are computed on apply instead
+		resp.Diagnostics.AddWarning(
+			"Unable to list planned deletions",
+			"Could not read schema versions of "+plan.subject()+", the versions to delete are only known on apply: "+err.Error(),
+		)
+		return
+	}
+
+	plan.PlannedDeletions, diags = subjectVersions.plannedDeletions()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("planned_deletions"), plan.PlannedDeletions)
+	resp.Diagnostics.Append(diags...)
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *subjectCleanupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
@@ -251,7 +362,16 @@ func (r *subjectCleanupResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	diags, err = SubjectCleanup(ctx, r.client, &plan)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	diags, err = SubjectCleanup(ctx, r.clients, &plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -282,7 +402,16 @@ func (r *subjectCleanupResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Deleting clean-up resource with effecting subject  %s", state.SubjectName.ValueString()))
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting clean-up resource with effecting subject  %s", state.subject()))
 }
 
 // Configure adds the provider configured client to the resource.
@@ -304,7 +433,7 @@ func (r *subjectCleanupResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	r.client = clients.SchemaRegistryClient
+	r.clients = clients
 }
 
 func (r *subjectCleanupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -4,11 +4,16 @@
 package provider
 
 import (
+	"bytes"
+	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestSubjectCleanupLatestHappyFlow(t *testing.T) {
@@ -48,13 +53,18 @@ resource "foxcon_subject_cleanup" "latest" {
     key = "` + api_key + `"
     secret = "` + api_secret + `"
   }
+  timeouts {
+    create = "5m"
+  }
 }
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "rest_endpoint", rest_endpoint),
+					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "timeouts.create", "5m"),
 					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "subject_name", subject_name),
 					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "cleanup_method", "KEEP_LATEST_ONLY"),
 					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "last_deleted.#", "4"),
+					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "planned_deletions.#", "4"),
 					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "last_deleted.0", "1"),
 					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "last_deleted.1", "2"),
 					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "last_deleted.2", "3"),
@@ -1548,3 +1558,55 @@ resource "foxcon_subject_cleanup" "test" {
 		},
 	})
 }
+
+func TestSubjectCleanupSkipsReferencedVersions(t *testing.T) {
+
+	subject := "cleanup-referenced"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := addSubjectVersions(subject, []int{1, 2, 3}); err != nil {
+						panic(err)
+					}
+
+					// Another schema references the first version
+					payload := `{"schemaType": "JSON", "schema": "{\"type\": \"object\"}", "references": [{"name": "base.json", "subject": "` + subject + `", "version": 1}]}`
+					_, _, err := callSchemaRegistry("POST", fmt.Sprintf("%s/subjects/%s-referencing/versions", rest_endpoint, subject), bytes.NewBufferString(payload))
+					if err != nil {
+						panic(err)
+					}
+				},
+				Config: cloudProviderConfig + `
+resource "foxcon_subject_cleanup" "latest" {
+  rest_endpoint = "` + rest_endpoint + `"
+  subject_name = "` + subject + `"
+  cleanup_method = "KEEP_LATEST_ONLY"
+  credentials {
+    key = "` + api_key + `"
+    secret = "` + api_secret + `"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("foxcon_subject_cleanup.latest", tfjsonpath.New("planned_deletions"),
+							knownvalue.ListExact([]knownvalue.Check{knownvalue.Int32Exact(2)})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "referenced_versions", "SKIP"),
+					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "last_deleted.#", "1"),
+					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "last_deleted.0", "2"),
+					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "skipped_referenced.#", "1"),
+					resource.TestCheckResourceAttr("foxcon_subject_cleanup.latest", "skipped_referenced.0", "1"),
+					func(s *terraform.State) error {
+						return validateSubjectVersions(subject, "[1,3]")
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,463 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &subjectCompatibilityResource{}
+	_ resource.ResourceWithConfigure      = &subjectCompatibilityResource{}
+	_ resource.ResourceWithValidateConfig = &subjectCompatibilityResource{}
+	_ resource.ResourceWithModifyPlan     = &subjectCompatibilityResource{}
+	_ resource.ResourceWithImportState    = &subjectCompatibilityResource{}
+)
+
+// NewSubjectCompatibilityResource is a helper function to simplify the provider implementation.
+func NewSubjectCompatibilityResource() resource.Resource {
+	return &subjectCompatibilityResource{}
+}
+
+// subjectCompatibilityResource is the resource implementation.
+type subjectCompatibilityResource struct {
+	clients *providerClients
+}
+
+// Metadata returns the resource type name.
+func (r *subjectCompatibilityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subject_compatibility"
+}
+
+// Schema defines the schema for the resource.
+func (r *subjectCompatibilityResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets subject compatibility level. Tightening the level fails the plan when the existing version history violates the new level.",
+		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"subject_name": schema.StringAttribute{
+				Required:    true,
+				Description: subjectNameDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context": resourceContextAttribute(),
+			"compatibility_level": schema.StringAttribute{
+				Required:    true,
+				Description: compatibilityLevelDescription,
+				Validators: []validator.String{
+					stringvalidator.OneOf(compatibilityLevels...),
+				},
+			},
+			"skip_history_check": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Skip the check of the existing version history when the compatibility level is tightened. The check fails the plan when the history violates the new level, or when the current level can not evaluate the new one, for example `NONE`, as Schema Registry evaluates compatibility checks with the level in effect. Defaults to `false`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type subjectCompatibilityResourceModel struct {
+	RestEndpoint       types.String      `tfsdk:"rest_endpoint"`
+	Registry           types.String      `tfsdk:"registry"`
+	SubjectName        types.String      `tfsdk:"subject_name"`
+	Context            types.String      `tfsdk:"context"`
+	CompatibilityLevel types.String      `tfsdk:"compatibility_level"`
+	SkipHistoryCheck   types.Bool        `tfsdk:"skip_history_check"`
+	Credentials        *credentialsModel `tfsdk:"credentials"`
+	TLS                *tlsModel         `tfsdk:"tls"`
+	Timeouts           timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// subject returns the subject name qualified with its context.
+func (m *subjectCompatibilityResourceModel) subject() string {
+	return qualifiedSubject(m.Context, m.SubjectName)
+}
+
+func (m *subjectCompatibilityResourceModel) client(clients *providerClients) (*Client, error) {
+	creds := schemaRegistryCredentials{
+		RestEndpoint: m.RestEndpoint,
+		Registry:     m.Registry,
+		Credentials:  m.Credentials,
+		TLS:          m.TLS,
+	}
+
+	return schemaRegistryClientFactory(clients, &creds)
+}
+
+func (r *subjectCompatibilityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config subjectCompatibilityResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateResourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ModifyPlan checks the version history of the subject before its compatibility level is tightened.
+func (r *subjectCompatibilityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan subjectCompatibilityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SkipHistoryCheck.ValueBool() || plan.SubjectName.IsUnknown() || plan.CompatibilityLevel.IsUnknown() ||
+		plan.RestEndpoint.IsUnknown() || plan.Registry.IsUnknown() {
+		return
+	}
+
+	if plan.Credentials != nil && (plan.Credentials.Key.IsUnknown() || plan.Credentials.Secret.IsUnknown()) {
+		return
+	}
+
+	schemaAPIClient, err := plan.client(r.clients)
+	if err != nil {
+		// Connection is validated on apply
+		tflog.Debug(ctx, "Skipping compatibility history check: "+err.Error())
+		return
+	}
+
+	subject := plan.subject()
+	level := plan.CompatibilityLevel.ValueString()
+
+	effective, err := EffectiveCompatibilityLevel(ctx, schemaAPIClient, subject)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Subject config",
+			"Could not read Subject config "+subject+": "+err.Error(),
+		)
+		return
+	}
+
+	if !newCompatibilityRule(level).tightens(newCompatibilityRule(effective)) {
+		return
+	}
+
+	// Schema Registry evaluates compatibility checks with the level in effect, the new one is only set on apply
+	if !newCompatibilityRule(effective).evaluates(newCompatibilityRule(level)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("compatibility_level"),
+			"Compatibility history can not be checked",
+			fmt.Sprintf("Version history of subject %s can not be checked against %s while the subject uses %s. "+
+				"Set skip_history_check to set the level without checking the history.", subject, level, effective),
+		)
+		return
+	}
+
+	violations, err := CompatibilityHistoryViolations(ctx, schemaAPIClient, subject, level, effective)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error checking compatibility history",
+			"Could not check version history of subject "+subject+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(violations) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("compatibility_level"),
+			"Incompatible version history",
+			fmt.Sprintf("Version history of subject %s violates compatibility level %s:\n- %s", subject, level, strings.Join(violations, "\n- ")),
+		)
+	}
+}
+
+// setCompatibility sets the planned level. The version history has been checked during the plan.
+func (r *subjectCompatibilityResource) setCompatibility(ctx context.Context, plan *subjectCompatibilityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaAPIClient, err := plan.client(r.clients)
+	if err != nil {
+		diags.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	subject := plan.subject()
+
+	response, err := SetSubjectCompatibility(ctx, schemaAPIClient, subject, plan.CompatibilityLevel.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error setting compatibility level",
+			"Could not set compatibility level of subject "+subject+": "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.CompatibilityLevel = types.StringValue(response.Compatibility)
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *subjectCompatibilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subjectCompatibilityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.setCompatibility(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *subjectCompatibilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state subjectCompatibilityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Get subject config
+	subjectConfig, err := GetSubjectConfig(ctx, schemaAPIClient, state.subject())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Subject config",
+			"Could not read Subject config "+state.subject()+": "+err.Error(),
+		)
+		return
+	}
+
+	if subjectConfig == nil {
+		state.CompatibilityLevel = types.StringNull()
+	} else {
+		state.CompatibilityLevel = types.StringPointerValue(subjectConfig.CompatibilityLevel)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *subjectCompatibilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan subjectCompatibilityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.setCompatibility(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *subjectCompatibilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state subjectCompatibilityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Other subject config fields are kept, the subject inherits the compatibility level again
+	err = ClearSubjectConfigFields(ctx, schemaAPIClient, state.subject(), []string{"compatibilityLevel"}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting subject compatibility level",
+			"Could not restore the inherited compatibility level of subject "+state.subject()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subjectCompatibilityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.clients = clients
+}
+
+// ImportState imports the compatibility level of a subject. Import ID is the subject name.
+func (r *subjectCompatibilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSubject(ctx, req.ID, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("skip_history_check"), false)...)
+	importSchemaRegistryCredentials(ctx, resp)
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func subjectCompatibilityResourceConfig(subject string, level string) string {
+	return schemaProviderConfig + `
+resource "foxcon_subject_compatibility" "test" {
+  subject_name = "` + subject + `"
+  compatibility_level = "` + level + `"
+}
+`
+}
+
+func TestSubjectCompatibilityResource(t *testing.T) {
+
+	subject := "compatibility-history"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					// Fixtures add properties to an open content model, which is forward compatible only
+					if err := addSubjectVersions(subject, []int{1, 2}); err != nil {
+						panic(err)
+					}
+				},
+				Config: subjectCompatibilityResourceConfig(subject, "FORWARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_subject_compatibility.test", "subject_name", subject),
+					resource.TestCheckResourceAttr("foxcon_subject_compatibility.test", "compatibility_level", "FORWARD"),
+					resource.TestCheckResourceAttr("foxcon_subject_compatibility.test", "skip_history_check", "false"),
+				),
+			},
+			// Tightening the level fails the plan
+			{
+				Config:      subjectCompatibilityResourceConfig(subject, "FULL"),
+				ExpectError: regexp.MustCompile(`Incompatible version history`),
+			},
+			{
+				Config: subjectCompatibilityResourceConfig(subject, "NONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_subject_compatibility.test", "compatibility_level", "NONE"),
+				),
+			},
+			// Plan fails when the current level can not evaluate the history
+			{
+				Config:      subjectCompatibilityResourceConfig(subject, "BACKWARD"),
+				ExpectError: regexp.MustCompile(`Compatibility history can not be checked`),
+			},
+			// ImportState testing
+			{
+				Config:                               subjectCompatibilityResourceConfig(subject, "NONE"),
+				ResourceName:                         "foxcon_subject_compatibility.test",
+				ImportState:                          true,
+				ImportStateId:                        subject,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subject_name",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
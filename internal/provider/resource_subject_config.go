@@ -0,0 +1,576 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// importedPrivateStateKey marks a resource imported but not read yet.
+const importedPrivateStateKey = "imported"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &subjectConfigResource{}
+	_ resource.ResourceWithConfigure      = &subjectConfigResource{}
+	_ resource.ResourceWithValidateConfig = &subjectConfigResource{}
+	_ resource.ResourceWithImportState    = &subjectConfigResource{}
+)
+
+// NewSubjectConfigResource is a helper function to simplify the provider implementation.
+func NewSubjectConfigResource() resource.Resource {
+	return &subjectConfigResource{}
+}
+
+// subjectConfigResource is the resource implementation.
+type subjectConfigResource struct {
+	clients *providerClients
+}
+
+// Metadata returns the resource type name.
+func (r *subjectConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subject_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *subjectConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets subject config fields. Fields that are not set are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
+			"rest_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: restEndpointDescription,
+				Validators: []validator.String{
+					EndpointValidator{},
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("key"),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("credentials").AtName("secret"),
+					),
+				},
+			},
+			"subject_name": schema.StringAttribute{
+				Required:    true,
+				Description: subjectNameDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context": resourceContextAttribute(),
+			"compatibility_level": schema.StringAttribute{
+				Optional:    true,
+				Description: compatibilityLevelDescription,
+				Validators: []validator.String{
+					stringvalidator.OneOf(compatibilityLevels...),
+				},
+			},
+			"normalize": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Normalize schemas registered under the subject.",
+			},
+			"alias": schema.StringAttribute{
+				Optional:    true,
+				Description: aliasDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"compatibility_group": schema.StringAttribute{
+				Optional:    true,
+				Description: "Metadata property whose value splits the subject versions into groups checked for compatibility separately.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"default_rule_set": schema.StringAttribute{
+				Optional:    true,
+				Description: "Rule set as a JSON document used for schemas registered without a rule set.",
+				Validators: []validator.String{
+					JSONValidator{},
+				},
+			},
+			"override_rule_set": schema.StringAttribute{
+				Optional:    true,
+				Description: "Rule set as a JSON document that overrides the rule set of registered schemas.",
+				Validators: []validator.String{
+					JSONValidator{},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_metadata":  resourceMetadataBlock("Metadata used for schemas registered without metadata."),
+			"override_metadata": resourceMetadataBlock("Metadata that overrides the metadata of registered schemas."),
+			"credentials": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Optional:    true,
+						Description: schemaRegistryKeyDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("secret"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+					"secret": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: schemaRegistrySecretDescription,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("credentials").AtName("key"),
+							),
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("rest_endpoint"),
+							),
+						},
+					},
+				},
+			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type subjectConfigResourceModel struct {
+	RestEndpoint       types.String         `tfsdk:"rest_endpoint"`
+	Registry           types.String         `tfsdk:"registry"`
+	SubjectName        types.String         `tfsdk:"subject_name"`
+	Context            types.String         `tfsdk:"context"`
+	CompatibilityLevel types.String         `tfsdk:"compatibility_level"`
+	Normalize          types.Bool           `tfsdk:"normalize"`
+	Alias              types.String         `tfsdk:"alias"`
+	CompatibilityGroup types.String         `tfsdk:"compatibility_group"`
+	DefaultRuleSet     types.String         `tfsdk:"default_rule_set"`
+	OverrideRuleSet    types.String         `tfsdk:"override_rule_set"`
+	DefaultMetadata    *schemaMetadataModel `tfsdk:"default_metadata"`
+	OverrideMetadata   *schemaMetadataModel `tfsdk:"override_metadata"`
+	Credentials        *credentialsModel    `tfsdk:"credentials"`
+	TLS                *tlsModel            `tfsdk:"tls"`
+	Timeouts           timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// subject returns the subject name qualified with its context.
+func (m *subjectConfigResourceModel) subject() string {
+	return qualifiedSubject(m.Context, m.SubjectName)
+}
+
+func (m *subjectConfigResourceModel) client(clients *providerClients) (*Client, error) {
+	creds := schemaRegistryCredentials{
+		RestEndpoint: m.RestEndpoint,
+		Registry:     m.Registry,
+		Credentials:  m.Credentials,
+		TLS:          m.TLS,
+	}
+
+	return schemaRegistryClientFactory(clients, &creds)
+}
+
+// attrs returns the managed config fields named as returned by parseResponseAttrs.
+func (m *subjectConfigResourceModel) attrs() []string {
+	var attrs []string
+	if !m.Alias.IsNull() {
+		attrs = append(attrs, "alias")
+	}
+	if !m.Normalize.IsNull() {
+		attrs = append(attrs, "normalize")
+	}
+	if !m.CompatibilityLevel.IsNull() {
+		attrs = append(attrs, "compatibilityLevel")
+	}
+	if !m.CompatibilityGroup.IsNull() {
+		attrs = append(attrs, "compatibilityGroup")
+	}
+	if m.DefaultMetadata != nil {
+		attrs = append(attrs, "defaultMetadata")
+	}
+	if m.OverrideMetadata != nil {
+		attrs = append(attrs, "overrideMetadata")
+	}
+	if !m.DefaultRuleSet.IsNull() {
+		attrs = append(attrs, "defaultRuleSet")
+	}
+	if !m.OverrideRuleSet.IsNull() {
+		attrs = append(attrs, "overrideRuleSet")
+	}
+	return attrs
+}
+
+// request builds the update request out of the managed fields.
+func (m *subjectConfigResourceModel) request(ctx context.Context) (SubjectConfigRequest, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	request := SubjectConfigRequest{
+		Alias:              m.Alias.ValueStringPointer(),
+		Normalize:          m.Normalize.ValueBoolPointer(),
+		Compatibility:      m.CompatibilityLevel.ValueStringPointer(),
+		CompatibilityGroup: m.CompatibilityGroup.ValueStringPointer(),
+	}
+
+	request.DefaultMetadata, d = m.DefaultMetadata.metadata(ctx)
+	diags.Append(d...)
+	request.OverrideMetadata, d = m.OverrideMetadata.metadata(ctx)
+	diags.Append(d...)
+
+	if !m.DefaultRuleSet.IsNull() {
+		ruleSet := json.RawMessage(m.DefaultRuleSet.ValueString())
+		request.DefaultRuleSet = &ruleSet
+	}
+	if !m.OverrideRuleSet.IsNull() {
+		ruleSet := json.RawMessage(m.OverrideRuleSet.ValueString())
+		request.OverrideRuleSet = &ruleSet
+	}
+
+	return request, diags
+}
+
+// ruleSetValue keeps the current value when the rule set returned by Schema Registry only differs in formatting.
+func ruleSetValue(current types.String, ruleSet *json.RawMessage) types.String {
+	if ruleSet == nil {
+		return types.StringNull()
+	}
+	if !current.IsNull() && jsonEqual(current.ValueString(), string(*ruleSet)) {
+		return current
+	}
+	return types.StringValue(string(*ruleSet))
+}
+
+// refresh copies the subject config into the managed fields. All fields present in the config
+// are copied when all is set.
+func (m *subjectConfigResourceModel) refresh(ctx context.Context, config *SchemaConfigResponse, all bool) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if config == nil {
+		config = &SchemaConfigResponse{}
+	}
+
+	if all || !m.Alias.IsNull() {
+		m.Alias = types.StringPointerValue(config.Alias)
+	}
+	if all || !m.Normalize.IsNull() {
+		m.Normalize = types.BoolPointerValue(config.Normalize)
+	}
+	if all || !m.CompatibilityLevel.IsNull() {
+		m.CompatibilityLevel = types.StringPointerValue(config.CompatibilityLevel)
+	}
+	if all || !m.CompatibilityGroup.IsNull() {
+		m.CompatibilityGroup = types.StringPointerValue(config.CompatibilityGroup)
+	}
+	if all || m.DefaultMetadata != nil {
+		m.DefaultMetadata, d = newSchemaMetadataModel(ctx, config.DefaultMetadata)
+		diags.Append(d...)
+	}
+	if all || m.OverrideMetadata != nil {
+		m.OverrideMetadata, d = newSchemaMetadataModel(ctx, config.OverrideMetadata)
+		diags.Append(d...)
+	}
+	if all || !m.DefaultRuleSet.IsNull() {
+		m.DefaultRuleSet = ruleSetValue(m.DefaultRuleSet, config.DefaultRuleSet)
+	}
+	if all || !m.OverrideRuleSet.IsNull() {
+		m.OverrideRuleSet = ruleSetValue(m.OverrideRuleSet, config.OverrideRuleSet)
+	}
+
+	return diags
+}
+
+func (r *subjectConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config subjectConfigResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds := schemaRegistryCredentials{
+		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
+		Credentials:  config.Credentials,
+		TLS:          config.TLS,
+	}
+
+	creds.ValidateResourceConfig(resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are not null, so the check only fails on configs without any field set
+	if len(config.attrs()) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing subject config field",
+			"At least one of 'compatibility_level', 'normalize', 'alias', 'compatibility_group', 'default_metadata', "+
+				"'override_metadata', 'default_rule_set' or 'override_rule_set' must be set.",
+		)
+	}
+}
+
+// apply clears the fields no longer managed and sets the planned ones.
+func (r *subjectConfigResource) apply(ctx context.Context, plan *subjectConfigResourceModel, state *subjectConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	schemaAPIClient, err := plan.client(r.clients)
+	if err != nil {
+		diags.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	subject := plan.subject()
+
+	if state != nil {
+		var removed []string
+		managed := plan.attrs()
+		for _, attr := range state.attrs() {
+			if !slices.Contains(managed, attr) {
+				removed = append(removed, attr)
+			}
+		}
+
+		if len(removed) > 0 {
+			// A compatibility level this resource does not manage may be managed by foxcon_subject_compatibility
+			err = ClearSubjectConfigFields(ctx, schemaAPIClient, subject, removed, append(managed, "compatibilityLevel"))
+			if err != nil {
+				diags.AddError(
+					"Error deleting subject configuration",
+					"Could not delete subject configuration fields: "+err.Error(),
+				)
+				return diags
+			}
+		}
+	}
+
+	request, d := plan.request(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	err = UpdateSubjectConfig(ctx, schemaAPIClient, subject, request)
+	if err != nil {
+		diags.AddError(
+			"Error Setting Subject config",
+			"Could not set Subject config "+subject+": "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *subjectConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan subjectConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the managed fields with the latest data.
+func (r *subjectConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state subjectConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Get subject config
+	subjectConfig, err := GetSubjectConfig(ctx, schemaAPIClient, state.subject())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Subject config",
+			"Could not read Subject config "+state.subject()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Imported resource does not manage any field yet
+	imported, diags := req.Private.GetKey(ctx, importedPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+	all := imported != nil && len(state.attrs()) == 0
+
+	resp.Diagnostics.Append(state.refresh(ctx, subjectConfig, all)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if imported != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, nil)...)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *subjectConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state subjectConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the managed fields and removes the Terraform state on success. Subject falls back
+// to the global config once no field is left.
+func (r *subjectConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state subjectConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	schemaAPIClient, err := state.client(r.clients)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating http client",
+			"Could not create http client. Unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// A compatibility level this resource does not manage may be managed by foxcon_subject_compatibility
+	err = ClearSubjectConfigFields(ctx, schemaAPIClient, state.subject(), state.attrs(), []string{"compatibilityLevel"})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting subject configuration",
+			"Could not delete subject configuration: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subjectConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*providerClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.clients = clients
+}
+
+// ImportState imports every field set in the subject config. Import ID is the subject name.
+func (r *subjectConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSubject(ctx, req.ID, resp)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, []byte(`true`))...)
+	importSchemaRegistryCredentials(ctx, resp)
+}
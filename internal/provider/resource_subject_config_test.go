@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestSubjectConfigResource(t *testing.T) {
+
+	subject := "subject-config"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			// Field set outside of Terraform is left untouched
+			return validateSubjectConfigFields(subject, []string{"normalize"})
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: schemaProviderConfig + `
+resource "foxcon_subject_config" "test" {
+  subject_name        = "` + subject + `"
+  compatibility_group = "application.major.version"
+  default_rule_set = jsonencode({
+    domainRules = [{
+      name = "checkLen"
+      kind = "CONDITION"
+      type = "CEL"
+      mode = "WRITE"
+      expr = "size(message.name) < 10"
+    }]
+  })
+  default_metadata {
+    properties = {
+      owner = "team-a"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_subject_config.test", "subject_name", subject),
+					resource.TestCheckResourceAttr("foxcon_subject_config.test", "compatibility_group", "application.major.version"),
+					resource.TestCheckResourceAttr("foxcon_subject_config.test", "default_metadata.properties.owner", "team-a"),
+					resource.TestCheckResourceAttrSet("foxcon_subject_config.test", "default_rule_set"),
+					resource.TestCheckNoResourceAttr("foxcon_subject_config.test", "normalize"),
+				),
+			},
+			// Update and Read testing
+			{
+				PreConfig: func() {
+					// Field set outside of Terraform is not managed by the resource
+					_, _, err := callSchemaRegistry("PUT", fmt.Sprintf("%s/config/%s", rest_endpoint, subject), bytes.NewBufferString(`{"normalize": true}`))
+					if err != nil {
+						panic(err)
+					}
+				},
+				Config: schemaProviderConfig + `
+resource "foxcon_subject_config" "test" {
+  subject_name        = "` + subject + `"
+  compatibility_group = "application.version"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_subject_config.test", "compatibility_group", "application.version"),
+					resource.TestCheckNoResourceAttr("foxcon_subject_config.test", "default_rule_set"),
+					resource.TestCheckNoResourceAttr("foxcon_subject_config.test", "normalize"),
+					func(_ *terraform.State) error {
+						return validateSubjectConfigFields(subject, []string{"normalize", "compatibilityGroup"})
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "foxcon_subject_config.test",
+				ImportState:                          true,
+				ImportStateId:                        subject,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subject_name",
+				ImportStateVerifyIgnore:              []string{"normalize", "compatibility_level"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
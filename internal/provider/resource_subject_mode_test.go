@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func subjectModeResourceConfig(subject string, mode string) string {
+	return schemaProviderConfig + `
+resource "foxcon_subject_mode" "test" {
+  subject_name = "` + subject + `"
+  mode = "` + mode + `"
+}
+
+data "foxcon_subject_mode" "test" {
+  subject_name = "` + subject + `"
+  depends_on = [foxcon_subject_mode.test]
+}
+`
+}
+
+func TestSubjectModeResource(t *testing.T) {
+
+	subject := "subject-mode"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: subjectModeResourceConfig(subject, "READONLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_subject_mode.test", "subject_name", subject),
+					resource.TestCheckResourceAttr("foxcon_subject_mode.test", "mode", "READONLY"),
+					resource.TestCheckResourceAttr("data.foxcon_subject_mode.test", "mode", "READONLY"),
+					resource.TestCheckResourceAttr("data.foxcon_subject_mode.test", "inherited", "false"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: subjectModeResourceConfig(subject, "READWRITE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_subject_mode.test", "mode", "READWRITE"),
+					resource.TestCheckResourceAttr("data.foxcon_subject_mode.test", "inherited", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "foxcon_subject_mode.test",
+				ImportState:                          true,
+				ImportStateId:                        subject,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subject_name",
+			},
+			// Destroy testing
+			{
+				Config: schemaProviderConfig + "",
+			},
+			// Subject inherits the global mode once the resource is destroyed
+			{
+				Config: schemaProviderConfig + `
+data "foxcon_subject_mode" "test" {
+  subject_name = "` + subject + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxcon_subject_mode.test", "mode", "READWRITE"),
+					resource.TestCheckResourceAttr("data.foxcon_subject_mode.test", "inherited", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestSubjectModeImportRequiresForce(t *testing.T) {
+
+	subject := "subject-mode-import"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := addSubjectVersions(subject, []int{1}); err != nil {
+						panic(err)
+					}
+				},
+				Config: schemaProviderConfig + `
+resource "foxcon_subject_mode" "test" {
+  subject_name = "` + subject + `"
+  mode = "IMPORT"
+}
+`,
+				ExpectError: regexp.MustCompile(`Set\s+force\s+to\s+switch\s+the\s+subject`),
+			},
+			{
+				Config: schemaProviderConfig + `
+resource "foxcon_subject_mode" "test" {
+  subject_name = "` + subject + `"
+  mode = "IMPORT"
+  force = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxcon_subject_mode.test", "mode", "IMPORT"),
+				),
+			},
+		},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -33,7 +34,7 @@ func NewSubjectNormalizationResource() resource.Resource {
 
 // subjectNormalizationResource is the resource implementation.
 type subjectNormalizationResource struct {
-	client *Client
+	clients *providerClients
 }
 
 // Metadata returns the resource type name.
@@ -42,10 +43,11 @@ func (r *subjectNormalizationResource) Metadata(_ context.Context, req resource.
 }
 
 // Schema defines the schema for the resource.
-func (r *subjectNormalizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *subjectNormalizationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Sets subject normalization.",
 		Attributes: map[string]schema.Attribute{
+			"registry": resourceRegistryAttribute(),
 			"rest_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: restEndpointDescription,
@@ -54,6 +56,7 @@ func (r *subjectNormalizationResource) Schema(_ context.Context, _ resource.Sche
 				Required:    true,
 				Description: subjectNameDescription,
 			},
+			"context": resourceContextAttribute(),
 			"normalization_enabled": schema.BoolAttribute{
 				Optional:    true,
 				Description: normalizationToggleDescription,
@@ -78,16 +81,31 @@ func (r *subjectNormalizationResource) Schema(_ context.Context, _ resource.Sche
 					},
 				},
 			},
+			"tls": resourceTLSBlock(),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
 type subjectNormalizationResourceModel struct {
 	RestEndpoint types.String      `tfsdk:"rest_endpoint"`
+	Registry     types.String      `tfsdk:"registry"`
 	SubjectName  types.String      `tfsdk:"subject_name"`
+	Context      types.String      `tfsdk:"context"`
 	Normalize    types.Bool        `tfsdk:"normalization_enabled"`
 	Credentials  *credentialsModel `tfsdk:"credentials"`
+	TLS          *tlsModel         `tfsdk:"tls"`
 	LastUpdated  types.String      `tfsdk:"last_updated"`
+	Timeouts     timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// subject returns the subject name qualified with its context.
+func (m *subjectNormalizationResourceModel) subject() string {
+	return qualifiedSubject(m.Context, m.SubjectName)
 }
 
 func (r *subjectNormalizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -101,7 +119,9 @@ func (r *subjectNormalizationResource) ValidateConfig(ctx context.Context, req r
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: config.RestEndpoint,
+		Registry:     config.Registry,
 		Credentials:  config.Credentials,
+		TLS:          config.TLS,
 	}
 
 	creds.ValidateResourceConfig(resp)
@@ -122,12 +142,23 @@ func (r *subjectNormalizationResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	creds := schemaRegistryCredentials{
 		RestEndpoint: plan.RestEndpoint,
+		Registry:     plan.Registry,
 		Credentials:  plan.Credentials,
+		TLS:          plan.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(r.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -149,7 +180,7 @@ func (r *subjectNormalizationResource) Create(ctx context.Context, req resource.
 	}
 
 	// Set Normalization
-	subjectConfig, err := SetSubjectConfig(schemaAPIClient, plan.SubjectName.ValueString(), normalizationPayload)
+	subjectConfig, err := SetSubjectConfig(ctx, schemaAPIClient, plan.subject(), normalizationPayload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error setting normalization",
@@ -183,10 +214,12 @@ func (r *subjectNormalizationResource) Read(ctx context.Context, req resource.Re
 
 	creds := schemaRegistryCredentials{
 		RestEndpoint: state.RestEndpoint,
+		Registry:     state.Registry,
 		Credentials:  state.Credentials,
+		TLS:          state.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(r.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -196,11 +229,11 @@ func (r *subjectNormalizationResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get subject config
-	subjectConfig, err := GetSubjectConfig(schemaAPIClient, state.SubjectName.ValueString())
+	subjectConfig, err := GetSubjectConfig(ctx, schemaAPIClient, state.subject())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Subject config",
-			"Could not read Subject config "+state.SubjectName.ValueString()+": "+err.Error(),
+			"Could not read Subject config "+state.subject()+": "+err.Error(),
 		)
 		return
 	}
@@ -235,12 +268,23 @@ func (r *subjectNormalizationResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	creds := schemaRegistryCredentials{
 		RestEndpoint: plan.RestEndpoint,
+		Registry:     plan.Registry,
 		Credentials:  plan.Credentials,
+		TLS:          plan.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(r.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -250,7 +294,7 @@ func (r *subjectNormalizationResource) Update(ctx context.Context, req resource.
 	}
 
 	// Get schema registry config
-	schemaRegistryConfig, err := GetSubjectConfig(schemaAPIClient, "")
+	schemaRegistryConfig, err := GetSubjectConfig(ctx, schemaAPIClient, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Schema config",
@@ -260,11 +304,11 @@ func (r *subjectNormalizationResource) Update(ctx context.Context, req resource.
 	}
 
 	// Get subject config
-	subjectConfig, err := GetSubjectConfig(schemaAPIClient, plan.SubjectName.ValueString())
+	subjectConfig, err := GetSubjectConfig(ctx, schemaAPIClient, plan.subject())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Subject config",
-			"Could not read Subject config "+plan.SubjectName.ValueString()+": "+err.Error(),
+			"Could not read Subject config "+plan.subject()+": "+err.Error(),
 		)
 		return
 	}
@@ -285,8 +329,8 @@ func (r *subjectNormalizationResource) Update(ctx context.Context, req resource.
 				reflect.DeepEqual(attrs, []string{"compatibilityLevel"})) {
 
 			// Delete subject config as CompatibilityLevels are identical (being inherited) and the second remaining value must be normalize
-			tflog.Debug(ctx, fmt.Sprintf("Deleting entire %s subject config", plan.SubjectName.ValueString()))
-			err = DeleteSubjectConfig(schemaAPIClient, plan.SubjectName.ValueString())
+			tflog.Debug(ctx, fmt.Sprintf("Deleting entire %s subject config", plan.subject()))
+			err = DeleteSubjectConfig(ctx, schemaAPIClient, plan.subject())
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error deleting subject configuration",
@@ -297,11 +341,11 @@ func (r *subjectNormalizationResource) Update(ctx context.Context, req resource.
 		}
 		plan.Normalize = types.BoolNull()
 	} else {
-		subjectConfig, err := SetSubjectConfig(schemaAPIClient, plan.SubjectName.ValueString(), normalizationPayload)
+		subjectConfig, err := SetSubjectConfig(ctx, schemaAPIClient, plan.subject(), normalizationPayload)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Setting Subject config",
-				"Could not set Subject config "+plan.SubjectName.ValueString()+": "+err.Error(),
+				"Could not set Subject config "+plan.subject()+": "+err.Error(),
 			)
 			return
 		}
@@ -330,12 +374,23 @@ func (r *subjectNormalizationResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	creds := schemaRegistryCredentials{
 		RestEndpoint: state.RestEndpoint,
+		Registry:     state.Registry,
 		Credentials:  state.Credentials,
+		TLS:          state.TLS,
 	}
 
-	schemaAPIClient, err := schemaRegistryClientFactory(r.client, &creds)
+	schemaAPIClient, err := schemaRegistryClientFactory(r.clients, &creds)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating http client",
@@ -345,7 +400,7 @@ func (r *subjectNormalizationResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Get schema registry config
-	schemaRegistryConfig, err := GetSubjectConfig(schemaAPIClient, "")
+	schemaRegistryConfig, err := GetSubjectConfig(ctx, schemaAPIClient, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Schema config",
@@ -355,11 +410,11 @@ func (r *subjectNormalizationResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Get subject config
-	subjectConfig, err := GetSubjectConfig(schemaAPIClient, state.SubjectName.ValueString())
+	subjectConfig, err := GetSubjectConfig(ctx, schemaAPIClient, state.subject())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Subject config",
-			"Could not read Subject config "+state.SubjectName.ValueString()+": "+err.Error(),
+			"Could not read Subject config "+state.subject()+": "+err.Error(),
 		)
 		return
 	}
@@ -375,8 +430,8 @@ func (r *subjectNormalizationResource) Delete(ctx context.Context, req resource.
 			reflect.DeepEqual(attrs, []string{"compatibilityLevel"})) {
 
 		// Delete subject config as CompatibilityLevels are identical (being inherited) and the second remaining value must be normalize
-		tflog.Debug(ctx, fmt.Sprintf("Deleting entire %s subject config", state.SubjectName.ValueString()))
-		err = DeleteSubjectConfig(schemaAPIClient, state.SubjectName.ValueString())
+		tflog.Debug(ctx, fmt.Sprintf("Deleting entire %s subject config", state.subject()))
+		err = DeleteSubjectConfig(ctx, schemaAPIClient, state.subject())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error deleting subject configuration",
@@ -386,12 +441,12 @@ func (r *subjectNormalizationResource) Delete(ctx context.Context, req resource.
 		}
 	} else {
 		// Delete normalization only
-		tflog.Debug(ctx, fmt.Sprintf("Deleting normalization toggle for %s subject config", state.SubjectName.ValueString()))
+		tflog.Debug(ctx, fmt.Sprintf("Deleting normalization toggle for %s subject config", state.subject()))
 		var normalizationPayload = NormalizeRequest{
 			Normalize: nil,
 		}
 
-		_, err = SetSubjectConfig(schemaAPIClient, state.SubjectName.ValueString(), normalizationPayload)
+		_, err = SetSubjectConfig(ctx, schemaAPIClient, state.subject(), normalizationPayload)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error setting normalization value to null",
@@ -422,7 +477,7 @@ func (r *subjectNormalizationResource) Configure(_ context.Context, req resource
 		return
 	}
 
-	r.client = clients.SchemaRegistryClient
+	r.clients = clients
 }
 
 func (r *subjectNormalizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -472,7 +527,7 @@ func (r *subjectNormalizationResource) ImportState(ctx context.Context, req reso
 
 	var rest_endpoint = types.StringValue(os.Getenv("IMPORT_SCHEMA_REGISTRY_REST_ENDPOINT"))
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject_name"), req.ID)...)
+	importSubject(ctx, req.ID, resp)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rest_endpoint"), rest_endpoint)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("credentials"), credentials)...)
 }
@@ -109,19 +109,19 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if !plan.InvitationId.IsNull() && !plan.InvitationId.IsUnknown() {
 		searchType = "invitation_id"
 		searchValue = plan.InvitationId.ValueString()
-		invitation, err = r.client.GetUserInvitationById(plan.InvitationId.ValueString())
+		invitation, err = r.client.GetUserInvitationById(ctx, plan.InvitationId.ValueString())
 	}
 
 	if !plan.UserEmail.IsNull() && !plan.UserEmail.IsUnknown() {
 		searchType = "user_email"
 		searchValue = plan.UserEmail.ValueString()
-		invitation, err = r.client.GetUserInvitationByParameter("email", plan.UserEmail.ValueString())
+		invitation, err = r.client.GetUserInvitationByParameter(ctx, "email", plan.UserEmail.ValueString())
 	}
 
 	if !plan.UserId.IsNull() && !plan.UserId.IsUnknown() {
 		searchType = "user_id"
 		searchValue = plan.UserId.ValueString()
-		invitation, err = r.client.GetUserInvitationByParameter("user", plan.UserId.ValueString())
+		invitation, err = r.client.GetUserInvitationByParameter(ctx, "user", plan.UserId.ValueString())
 	}
 
 	if err != nil {
@@ -173,19 +173,19 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if !state.InvitationId.IsNull() {
 		searchType = "invitation_id"
 		searchValue = state.InvitationId.ValueString()
-		invitation, err = r.client.GetUserInvitationById(state.InvitationId.ValueString())
+		invitation, err = r.client.GetUserInvitationById(ctx, state.InvitationId.ValueString())
 	}
 
 	if !state.UserEmail.IsNull() {
 		searchType = "user_email"
 		searchValue = state.InvitationId.ValueString()
-		invitation, err = r.client.GetUserInvitationByParameter("email", state.UserEmail.ValueString())
+		invitation, err = r.client.GetUserInvitationByParameter(ctx, "email", state.UserEmail.ValueString())
 	}
 
 	if !state.UserId.IsNull() {
 		searchType = "user_id"
 		searchValue = state.InvitationId.ValueString()
-		invitation, err = r.client.GetUserInvitationByParameter("user", state.UserId.ValueString())
+		invitation, err = r.client.GetUserInvitationByParameter(ctx, "user", state.UserId.ValueString())
 	}
 
 	if err != nil {
@@ -234,15 +234,15 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if !plan.InvitationId.IsNull() {
-		invitation, err = r.client.GetUserInvitationById(plan.InvitationId.ValueString())
+		invitation, err = r.client.GetUserInvitationById(ctx, plan.InvitationId.ValueString())
 	}
 
 	if !plan.UserEmail.IsNull() {
-		invitation, err = r.client.GetUserInvitationByParameter("email", plan.UserEmail.ValueString())
+		invitation, err = r.client.GetUserInvitationByParameter(ctx, "email", plan.UserEmail.ValueString())
 	}
 
 	if !plan.UserId.IsNull() {
-		invitation, err = r.client.GetUserInvitationByParameter("user", plan.UserId.ValueString())
+		invitation, err = r.client.GetUserInvitationByParameter(ctx, "user", plan.UserId.ValueString())
 	}
 
 	if err != nil {
@@ -277,7 +277,7 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	// Delete existing user
-	err := r.client.DeleteUser(state.UserId.ValueString())
+	err := r.client.DeleteUser(ctx, state.UserId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Confluent User",
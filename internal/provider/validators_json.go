@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type JSONValidator struct{}
+
+func (v JSONValidator) Description(_ context.Context) string {
+	return "String must be a valid JSON document"
+}
+
+func (v JSONValidator) MarkdownDescription(_ context.Context) string {
+	return "String must be a valid JSON document"
+}
+
+func (v JSONValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			"The value must be a valid JSON document.",
+		)
+	}
+}
+
+// jsonEqual reports whether both strings are semantically equal JSON documents.
+func jsonEqual(a, b string) bool {
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type RegexValidator struct{}
+
+func (v RegexValidator) Description(_ context.Context) string {
+	return "String must be a valid regular expression"
+}
+
+func (v RegexValidator) MarkdownDescription(_ context.Context) string {
+	return "String must be a valid regular expression"
+}
+
+func (v RegexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid regular expression",
+			"The value must be a valid regular expression: "+err.Error(),
+		)
+	}
+}